@@ -521,6 +521,7 @@ func NewRpcServer(bus *bus.AsyncEventBus) *RpcServer {
 	srv.bus = bus
 	srv.rpc = grpc.NewServer(
 		grpc.UnaryInterceptor(CustomRpcServerInterceptor()),
+		grpc.StreamInterceptor(CustomRpcServerStreamInterceptor()),
 	)
 	if srv.rpc == nil {
 		return nil
@@ -541,9 +542,7 @@ func CustomRpcServerInterceptor() grpc.UnaryServerInterceptor {
 
 		md, _ := metadata.FromIncomingContext(ctx)
 		zixelInvokerLevel := md.Get("Zixel-Log-InvokerLevel")
-		zixelResponseLevel := md.Get("Zixel-Log-ResponseLevel")
 		zixelRequestId := md.Get("Zixel-Log-RequestId")
-		zixelLogProtocol := "grpc"
 		zixelLogRequestIgnored := md.Get("Zixel-Log-RequestIgnored")
 		zixelLogResponseIgnored := md.Get("Zixel-Log-ResponseIgnored")
 
@@ -588,45 +587,7 @@ func CustomRpcServerInterceptor() grpc.UnaryServerInterceptor {
 			zixelInvokerLevel = []string{"01"}
 		}
 
-		var keys []string
-		var values []string
-
-		// Update the level number for the next service
-		if zixelInvokerLevel != nil {
-			keys = append(keys, "Zixel-Log-InvokerLevel")
-			values = append(values, zixelInvokerLevel[0])
-		}
-
-		if zixelResponseLevel != nil {
-			keys = append(keys, "Zixel-Log-ResponseLevel")
-			values = append(values, zixelResponseLevel[0])
-		}
-
-		keys = append(keys, "Zixel-Log-Protocol")
-		values = append(values, zixelLogProtocol)
-
-		if zixelRequestId != nil {
-			keys = append(keys, "Zixel-Log-RequestId")
-			values = append(values, zixelRequestId[0])
-		}
-
-		if zixelLogRequestIgnored != nil {
-			keys = append(keys, "Zixel-Log-RequestIgnored")
-			values = append(values, zixelLogRequestIgnored[0])
-		}
-
-		if zixelLogResponseIgnored != nil {
-			keys = append(keys, "Zixel-Log-ResponseIgnored")
-			values = append(values, zixelLogResponseIgnored[0])
-		}
-
-		var headers []string
-		for i := 0; i < len(keys); i++ {
-			headers = append(headers, keys[i], values[i])
-		}
-		pairs := metadata.Pairs(headers...)
-
-		ctx = metadata.NewIncomingContext(ctx, pairs)
+		ctx = metadata.NewIncomingContext(ctx, logHeaders(md, zixelInvokerLevel[0]))
 
 		resp, err := handler(ctx, req)
 
@@ -648,20 +609,6 @@ func CustomRpcServerInterceptor() grpc.UnaryServerInterceptor {
 			// Transform into structure
 
 		}
-		// After request
-		counter.Add(1)
-		latency := float64(time.Since(t)) / float64(time.Millisecond)
-		p, _ := peer.FromContext(ctx)
-		callerIP := p.Addr.String()
-
-		// Get the gRPC status code and message
-		st, _ := grpcStatus.FromError(err)
-		grpcCode := st.Code()
-		grpcMessage := st.Message()
-		if grpcMessage == "" {
-			grpcMessage = "OK"
-		}
-
 		var response interface{}
 		if zixelLogResponseIgnored != nil {
 			if zixelLogResponseIgnored[0] == "true" {
@@ -691,41 +638,107 @@ func CustomRpcServerInterceptor() grpc.UnaryServerInterceptor {
 			}
 		}
 
-		fullMethod := info.FullMethod
-		if strings.HasPrefix(fullMethod, "/") {
-			fullMethod = fullMethod[1:]
+		writeReceiveLog(ctx, t, &counter, info.FullMethod, requestId, zixelInvokerLevel[0], request, response, err)
+		return resp, err
+	}
+}
+
+// serverStream overrides the context of a grpc.ServerStream
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func CustomRpcServerStreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		// Before Request
+		t := time.Now()
+
+		counter := atomic.Int64{}
+		counter.Store(0)
+		ctx := context.WithValue(ss.Context(), "counter", &counter)
+
+		md, _ := metadata.FromIncomingContext(ctx)
+		zixelInvokerLevel := md.Get("Zixel-Log-InvokerLevel")
+		zixelRequestId := md.Get("Zixel-Log-RequestId")
+
+		var requestId string
+		if zixelRequestId != nil {
+			requestId = zixelRequestId[0]
 		}
-		// split fullMethod
-		methods := strings.Split(fullMethod, "/")
-		protoService := methods[0]
-		protoMethod := methods[1]
 
-		responseLevel := zixelInvokerLevel[0] + "." + fmt.Sprintf("%02d", counter.Load())
+		if zixelInvokerLevel == nil {
+			zixelInvokerLevel = []string{"01"}
+		}
 
-		logLine := fmt.Sprintf("[receive-log]%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%d|%s|%s\n",
-			requestId,                            // RequestId
-			t.Format("2006-01-02 15:04:05.000"),  // Timestamp
-			"grpc",                               // Caller service name
-			protoService,                         // Calling method
-			protoMethod,                          // Calling method name
-			fmt.Sprintf("%v", request),           // Caller params
-			callerIP,                             // Caller IP
-			zixelInvokerLevel[0],                 // Caller level number
-			config.GetString("service.name", ""), // Responder service name
-			info.FullMethod,                      // Responder method
-			response,                             // Return parameters
-			serverIp,                             // Responder IP
-			responseLevel,                        // Responder level number
-			grpcCode,                             // System return code
-			grpcMessage,                          // System return message
-			fmt.Sprintf("%.2fms", latency),       // Latency
-		)
+		ctx = metadata.NewIncomingContext(ctx, logHeaders(md, zixelInvokerLevel[0]))
 
-		serverLogger.Info(logLine)
-		return resp, err
+		err := handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+
+		writeReceiveLog(ctx, t, &counter, info.FullMethod, requestId, zixelInvokerLevel[0], "##stream##", "##stream##", err)
+		return err
 	}
 }
 
+// logHeaders returns the headers of the caller forwarded to the handler, the common headers
+// are kept and only the log headers are replaced with the invoker level of the call
+func logHeaders(md metadata.MD, invokerLevel string) metadata.MD {
+	pairs := md.Copy()
+	pairs.Set("Zixel-Log-InvokerLevel", invokerLevel)
+	pairs.Set("Zixel-Log-Protocol", "grpc")
+	return pairs
+}
+
+// writeReceiveLog writes the receive log line of a finished call
+func writeReceiveLog(ctx context.Context, t time.Time, counter *atomic.Int64, fullMethod string, requestId string, invokerLevel string, request interface{}, response interface{}, err error) {
+	counter.Add(1)
+	latency := float64(time.Since(t)) / float64(time.Millisecond)
+	var callerIP string
+	if p, ok := peer.FromContext(ctx); ok {
+		callerIP = p.Addr.String()
+	}
+
+	// Get the gRPC status code and message
+	st, _ := grpcStatus.FromError(err)
+	grpcCode := st.Code()
+	grpcMessage := st.Message()
+	if grpcMessage == "" {
+		grpcMessage = "OK"
+	}
+
+	// split fullMethod
+	methods := strings.Split(strings.TrimPrefix(fullMethod, "/"), "/")
+	protoService := methods[0]
+	protoMethod := methods[len(methods)-1]
+
+	responseLevel := invokerLevel + "." + fmt.Sprintf("%02d", counter.Load())
+
+	logLine := fmt.Sprintf("[receive-log]%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%s|%d|%s|%s\n",
+		requestId,                            // RequestId
+		t.Format("2006-01-02 15:04:05.000"),  // Timestamp
+		"grpc",                               // Caller service name
+		protoService,                         // Calling method
+		protoMethod,                          // Calling method name
+		fmt.Sprintf("%v", request),           // Caller params
+		callerIP,                             // Caller IP
+		invokerLevel,                         // Caller level number
+		config.GetString("service.name", ""), // Responder service name
+		fullMethod,                           // Responder method
+		response,                             // Return parameters
+		serverIp,                             // Responder IP
+		responseLevel,                        // Responder level number
+		grpcCode,                             // System return code
+		grpcMessage,                          // System return message
+		fmt.Sprintf("%.2fms", latency),       // Latency
+	)
+
+	serverLogger.Info(logLine)
+}
+
 func CustomRpcClientInterceptor(address string) grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		// Before Request
@@ -949,7 +962,8 @@ func (s *RpcServer) GetCommonHeaders(ctx context.Context, h any) error {
 	for i := 0; i < v.NumField(); i++ {
 		if v.Field(i).CanSet() {
 			tag := v.Type().Field(i).Tag
-			if val, ok := headers[tag.Get("json")]; ok {
+			// metadata keys are always lower case
+			if val, ok := headers[strings.ToLower(tag.Get("json"))]; ok {
 				v.Field(i).SetString(val)
 			}
 		}
@@ -0,0 +1,69 @@
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// testServerStream is a grpc.ServerStream that only has a context
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestInterceptorsKeepCommonHeaders(t *testing.T) {
+	// gRPC sends the metadata keys in lower case
+	md := metadata.Pairs(
+		"zixel-organization-id", "tenant",
+		"zixel-user-id", "user",
+		"zixel-application-id", "app",
+		"zixel-log-requestid", "request",
+	)
+	want := CommonHeaders{TenantId: "tenant", ZixelUserId: "user", AppId: "app"}
+
+	check := func(t *testing.T, ctx context.Context) {
+		var headers CommonHeaders
+		if err := (&RpcServer{}).GetCommonHeaders(ctx, &headers); err != nil {
+			t.Fatalf("GetCommonHeaders: %v", err)
+		}
+		if headers != want {
+			t.Errorf("GetCommonHeaders = %+v, want %+v", headers, want)
+		}
+		md, _ := metadata.FromIncomingContext(ctx)
+		if level := md.Get("Zixel-Log-InvokerLevel"); len(level) != 1 || level[0] != "01" {
+			t.Errorf("Zixel-Log-InvokerLevel = %v, want 01", level)
+		}
+	}
+
+	t.Run("unary", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Unary"}
+		_, err := CustomRpcServerInterceptor()(ctx, &emptypb.Empty{}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			check(t, ctx)
+			return &emptypb.Empty{}, nil
+		})
+		if err != nil {
+			t.Errorf("interceptor: %v", err)
+		}
+	})
+
+	t.Run("stream", func(t *testing.T) {
+		ss := &testServerStream{ctx: metadata.NewIncomingContext(context.Background(), md)}
+		info := &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}
+		err := CustomRpcServerStreamInterceptor()(nil, ss, info, func(srv interface{}, stream grpc.ServerStream) error {
+			check(t, stream.Context())
+			return nil
+		})
+		if err != nil {
+			t.Errorf("interceptor: %v", err)
+		}
+	})
+}
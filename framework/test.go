@@ -2,10 +2,14 @@ package framework
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"net/http/httptest"
 
 	"gitlab.zixel.cn/go/framework/variant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type TestRequest struct {
@@ -39,3 +43,14 @@ func DoTestRequest(r TestRequest) (obj variant.AbstractValue, code int, err erro
 
 	return variant.New(res), w.Code, err
 }
+
+// DoTestRpcHeaders reads the common headers of a gRPC call with the metadata into h,
+// the call goes through the interceptor of the server like every call a handler receives.
+func DoTestRpcHeaders(md metadata.MD, h any) error {
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/Headers"}
+	_, err := CustomRpcServerInterceptor()(ctx, &emptypb.Empty{}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return &emptypb.Empty{}, GetCommonHeaders(ctx, h)
+	})
+	return err
+}
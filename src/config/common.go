@@ -18,6 +18,26 @@ var (
 	TaskLimit  = config.GetInt("task_limit", 0)
 	JobTimeout = int(config.GetInt("job_timeout", 0))
 
+	JobSyncInterval = time.Duration(config.GetInt("job_sync_interval", 5)) * time.Second
+	EstimateHistory = config.GetInt("estimate_history", 100)
+
+	IdempotencyKeyExpire = time.Duration(config.GetInt("idempotency_key_expire", 86400)) * time.Second
+	CreateJobsLimit      = config.GetInt("create_jobs_limit", 1000)
+
+	PriorityTenantTiers = config.GetObject("priority.tenant_tiers")
+	PriorityQueueSuffix = config.GetString("priority.vip_queue_suffix", "-vip")
+
+	UsageAdminTenants = config.GetArray("usage.admin_tenants")
+
+	FairDefaultWeight = config.GetInt("fair_scheduling.default_weight", 1)
+	FairAgingPeriod   = time.Duration(config.GetInt("fair_scheduling.aging_period", 60)) * time.Second
+
+	WebhookMaxAttempts    = int32(config.GetInt("webhook.max_attempts", 8))
+	WebhookInitialBackoff = time.Duration(config.GetInt("webhook.initial_backoff", 5)) * time.Second
+	WebhookMaxBackoff     = time.Duration(config.GetInt("webhook.max_backoff", 3600)) * time.Second
+	WebhookTimeout        = time.Duration(config.GetInt("webhook.timeout", 10)) * time.Second
+	WebhookWorkers        = int(config.GetInt("webhook.workers", 4))
+
 	MailHost     = config.GetString("mail.host", "")
 	MailPort     = int(config.GetInt("mail.port", 0))
 	MailUser     = config.GetString("mail.user", "")
@@ -40,6 +60,7 @@ var (
 
 	TopicOperationLogMap = config.GetObject("mq_config.topic_config.topicOperationLog.service_key_map")
 	TopicJobProgressMap  = config.GetObject("mq_config.topic_config.topicJobProgress.service_key_map")
+	TopicJobProgress     = config.GetString("mq_config.topic_config.topicJobProgress.namespace", "topicJobProgress")
 
 	ClearJobConfigAppIds      = config.GetArray("clear_job_config.appId")
 	ClearJobConfigInstanceIds = config.GetArray("clear_job_config.instanceId")
@@ -48,6 +69,7 @@ var (
 
 	TemporalAddress   = config.GetString("temporal.address", "localhost:7233")
 	TemporalNamespace = config.GetString("temporal.namespace", "default")
+	ScheduleTaskQueue = config.GetString("temporal.schedule_task_queue", "transform-schedules")
 
 	EquityConfigMap = config.GetObject("equity_config")
 
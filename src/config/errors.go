@@ -1,6 +1,10 @@
 package config
 
-import "gitlab.zixel.cn/go/framework"
+import (
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+)
 
 var ErrorCodes = map[int]string{
 	1000: "Server internal error.",
@@ -21,6 +25,59 @@ var ErrorCodes = map[int]string{
 	30201: "Too many tasks, try again later. ",
 	30202: "add Servers is  error. ",
 	30203: "config is  error. ",
+
+	/// Job failures, see ErrorCategoryCodes
+	30300: "Invalid job request.",
+	30301: "File format is not supported.",
+	30302: "Job queue is full.",
+	30303: "Insufficient resources.",
+	30304: "Conversion failed.",
+	30305: "Job timed out.",
+	30306: "Job cancelled.",
+	30307: "Job failed.",
+	30308: "Quota exceeded.",
+	30309: "Job not found.",
+}
+
+// ErrorCategoryCodes maps the job error categories to their error codes.
+var ErrorCategoryCodes = map[string]int{
+	models.ErrorInvalidRequest:        30300,
+	models.ErrorUnsupportedFormat:     30301,
+	models.ErrorQueueFull:             30302,
+	models.ErrorInsufficientResources: 30303,
+	models.ErrorConversionFailed:      30304,
+	models.ErrorTimeout:               30305,
+	models.ErrorCancelled:             30306,
+	models.ErrorInternal:              30307,
+	models.ErrorQuotaExceeded:         30308,
+	models.ErrorNotFound:              30309,
+}
+
+// NewJobError returns a service error carrying the code of the job error category.
+func NewJobError(category string, message string) error {
+	code, ok := ErrorCategoryCodes[category]
+	if !ok {
+		code = ErrorCategoryCodes[models.ErrorInternal]
+	}
+	if message == "" {
+		message = ErrorCodes[code]
+	}
+	return framework.NewServiceError(code, message)
+}
+
+// ErrorCategory returns the job error category of a service error, errors without one are Internal.
+func ErrorCategory(err error) string {
+	if serviceErr, ok := err.(framework.ServiceError); ok {
+		for category, code := range ErrorCategoryCodes {
+			if code == serviceErr.Code {
+				return category
+			}
+		}
+		if serviceErr.Code == framework.ERR_SYS_PARAMETER {
+			return models.ErrorInvalidRequest
+		}
+	}
+	return models.ErrorInternal
 }
 
 func NewErrorNo(code int, message string, err error) *ErrorNo {
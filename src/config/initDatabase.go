@@ -1,9 +1,13 @@
 package config
 
 import (
+	"context"
 	"errors"
 	"gitlab.zixel.cn/go/framework/database"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
 )
 
 var JobsCollection *mongo.Collection = nil
@@ -13,6 +17,14 @@ var EquityCollection *mongo.Collection = nil
 var JobTypeCollection *mongo.Collection = nil
 var JobSetCollection *mongo.Collection = nil
 var RpTypeCollection *mongo.Collection = nil
+var JobEventCollection *mongo.Collection = nil
+var IdempotencyKeyCollection *mongo.Collection = nil
+var QuotaCollection *mongo.Collection = nil
+var UsageCollection *mongo.Collection = nil
+var PoolUptimeCollection *mongo.Collection = nil
+var PoolQueueCollection *mongo.Collection = nil
+var WebhookCollection *mongo.Collection = nil
+var WebhookDeliveryCollection *mongo.Collection = nil
 
 func InitMongoDB() (err error) {
 	if JobsCollection = database.GetCollection("jobs"); JobsCollection == nil {
@@ -46,5 +58,84 @@ func InitMongoDB() (err error) {
 		err = errors.New("jobsType collection not found")
 		return
 	}
+
+	if JobEventCollection = database.GetCollection("jobEvents"); JobEventCollection == nil {
+		err = errors.New("jobEvents collection not found")
+		return
+	}
+
+	if IdempotencyKeyCollection = database.GetCollection("idempotencyKeys"); IdempotencyKeyCollection == nil {
+		err = errors.New("idempotencyKeys collection not found")
+		return
+	}
+
+	if QuotaCollection = database.GetCollection("quotas"); QuotaCollection == nil {
+		err = errors.New("quotas collection not found")
+		return
+	}
+
+	if UsageCollection = database.GetCollection("usage"); UsageCollection == nil {
+		err = errors.New("usage collection not found")
+		return
+	}
+
+	if PoolUptimeCollection = database.GetCollection("poolUptime"); PoolUptimeCollection == nil {
+		err = errors.New("poolUptime collection not found")
+		return
+	}
+
+	if PoolQueueCollection = database.GetCollection("poolQueues"); PoolQueueCollection == nil {
+		err = errors.New("poolQueues collection not found")
+		return
+	}
+
+	if WebhookCollection = database.GetCollection("webhooks"); WebhookCollection == nil {
+		err = errors.New("webhooks collection not found")
+		return
+	}
+
+	if WebhookDeliveryCollection = database.GetCollection("webhookDeliveries"); WebhookDeliveryCollection == nil {
+		err = errors.New("webhookDeliveries collection not found")
+		return
+	}
+
+	// Let the database remove the expired keys
+	if _, err = IdempotencyKeyCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "ExpireAt", Value: 1}},
+		Options: options.Index().SetExpireAfterSeconds(0),
+	}); err != nil {
+		return
+	}
+
+	// Watchers read the events after a sequence number, of all jobs, of a job or of an organization
+	if _, err = JobEventCollection.Indexes().CreateMany(context.Background(), []mongo.IndexModel{
+		{Keys: bson.D{{Key: "Seq", Value: 1}}, Options: options.Index().SetUnique(true)},
+		{Keys: bson.D{{Key: "JobId", Value: 1}, {Key: "Seq", Value: 1}}},
+		{Keys: bson.D{{Key: "TenantId", Value: 1}, {Key: "Seq", Value: 1}}},
+	}); err != nil {
+		return
+	}
+
+	// Jobs are looked up by their ID
+	if _, err = JobsCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "JobId", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}); err != nil {
+		return
+	}
+
+	// A pool has one count of the places taken in its queue
+	if _, err = PoolQueueCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "ResourcePoolId", Value: 1}},
+		Options: options.Index().SetUnique(true),
+	}); err != nil {
+		return
+	}
+
+	// A pool has at most one open uptime interval
+	_, err = PoolUptimeCollection.Indexes().CreateOne(context.Background(), mongo.IndexModel{
+		Keys:    bson.D{{Key: "ResourcePoolId", Value: 1}},
+		Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"StoppedAt": time.Time{}}),
+	})
 	return
 }
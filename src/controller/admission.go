@@ -0,0 +1,86 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"transform2/config"
+	"transform2/dispatch"
+	"transform2/models"
+	"transform2/service"
+
+	"gitlab.zixel.cn/go/framework"
+)
+
+// admitJobs returns the jobs that fit into the queues of their pools. Every admitted job of a pool
+// with a QueueLimit takes a place in its queue, which it gives back when it finishes. Jobs finding
+// no free place are rejected with the QueueFull category, jobs whose estimated resources need more
+// slots than the pool grants their job type are rejected with the InsufficientResources category.
+// Rejected jobs release their idempotency keys and places, with allOrNothing a single rejected job
+// rejects the whole batch.
+func admitJobs(ctx context.Context, headers framework.CommonHeaders, subs []*jobSubmission, allOrNothing bool) []*jobSubmission {
+	pools := make(map[string]*models.ResourcePool)
+	for _, sub := range subs {
+		poolId := sub.job.ResourcePoolId
+		if poolId == "" {
+			continue
+		}
+
+		pool, ok := pools[poolId]
+		if !ok {
+			var err error
+			if pool, err = service.GetResourcePool(ctx, poolId); err != nil {
+				sub.err = err
+				continue
+			}
+			pools[poolId] = pool
+		}
+
+		slots, capacity := pool.JobCapacity(sub.job)
+		if capacity >= 0 && slots > capacity {
+			log.Warnf("Job %s needs %d slots of resource pool %s, the pool grants %d", sub.job.JobId, slots, poolId, capacity)
+			sub.err = config.NewJobError(models.ErrorInsufficientResources, fmt.Sprintf("Job needs %d Slots of Resource Pool %s, it grants %d", slots, poolId, capacity))
+			continue
+		}
+		if pool.QueueLimit <= 0 {
+			continue
+		}
+
+		reserved, err := service.ReservePoolQueue(ctx, poolId, int64(pool.QueueLimit))
+		switch {
+		case err != nil:
+			sub.err = err
+		case !reserved:
+			log.Warnf("Queue of resource pool %s is full, %d jobs", poolId, pool.QueueLimit)
+			sub.err = config.NewJobError(models.ErrorQueueFull, fmt.Sprintf("Queue of Resource Pool %s is full, try again later", poolId))
+		default:
+			sub.job.QueueReserved = true
+		}
+	}
+
+	admitted, rejected := dispatch.SplitBatch(subs, (*jobSubmission).rejected, allOrNothing)
+	for _, sub := range rejected {
+		if sub.err == nil {
+			sub.err = config.NewJobError(models.ErrorQueueFull, "Rejected with a Job of the Batch past the Queue Limit")
+		}
+		sub.release(ctx, headers)
+	}
+
+	return admitted
+}
+
+// releaseJobQueue gives the place of the finished job in the queue of its pool to the next
+// submission. The place is cleared on the stored job first, so it is only given back once.
+func releaseJobQueue(ctx context.Context, job *models.Job) {
+	if !job.QueueReserved {
+		return
+	}
+	job.QueueReserved = false
+
+	claimed, err := service.ClaimJobQueueRelease(ctx, job.JobId)
+	if err != nil || !claimed {
+		return
+	}
+	if err := service.ReleasePoolQueue(ctx, job.ResourcePoolId, 1); err != nil {
+		log.Errorf("Failed to release the queue place of job %s: %v", job.JobId, err)
+	}
+}
@@ -0,0 +1,221 @@
+package controller
+
+import (
+	"context"
+	"math"
+	"time"
+	"transform2/config"
+	"transform2/dispatch"
+	"transform2/models"
+	"transform2/service"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.temporal.io/sdk/client"
+)
+
+// dispatchNotify wakes up the dispatcher when jobs were queued for a shared pool.
+var dispatchNotify = make(chan struct{}, 1)
+
+// notifyDispatcher asks the dispatcher to run soon, a pending request already covers new jobs.
+func notifyDispatcher() {
+	select {
+	case dispatchNotify <- struct{}{}:
+	default:
+	}
+}
+
+// DispatchJobs starts the workflows of the jobs waiting for a shared resource pool until the context is done,
+// paused jobs waiting to resume get their workflows resumed.
+// The slots of a pool are handed out in proportion to the weights of the organizations,
+// waiting jobs age so a low weight organization is never starved.
+func DispatchJobs(ctx context.Context, c client.Client, interval time.Duration) {
+	log.Infof("Job dispatcher started, interval %v", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Job dispatcher stopped")
+			return
+		case <-ticker.C:
+		case <-dispatchNotify:
+		}
+		dispatchQueuedJobs(ctx, c)
+	}
+}
+
+// dispatchQueuedJobs fills the free slots of every pool that has waiting jobs, delayed jobs wait until their start time.
+func dispatchQueuedJobs(ctx context.Context, c client.Client) {
+	filter := waitingJobsFilter(time.Now())
+	filter["ResourcePoolId"] = bson.M{"$ne": ""}
+	waiting, err := service.GetJobs(ctx, filter, bson.D{{Key: "CreatedAt", Value: 1}}, 0)
+	if err != nil {
+		log.Errorf("Failed to load waiting jobs: %v", err)
+		return
+	}
+	if len(waiting) == 0 {
+		return
+	}
+
+	weights, err := service.GetTenantWeights(ctx)
+	if err != nil {
+		log.Errorf("Failed to load tenant weights: %v", err)
+		return
+	}
+
+	byPool := make(map[string][]*models.Job)
+	for i := range waiting {
+		job := &waiting[i]
+		byPool[job.ResourcePoolId] = append(byPool[job.ResourcePoolId], job)
+	}
+
+	for poolId, jobs := range byPool {
+		if err := dispatchPool(ctx, c, poolId, jobs, weights); err != nil {
+			log.Errorf("Failed to dispatch jobs of pool %s: %v", poolId, err)
+		}
+	}
+}
+
+// waitingJobsFilter matches the jobs waiting for the dispatcher to start them, delayed jobs wait until their start time.
+func waitingJobsFilter(now time.Time) bson.M {
+	return bson.M{
+		"Status":       models.JobStatusQueued,
+		"DispatchedAt": time.Time{},
+		"NotBefore":    bson.M{"$not": bson.M{"$gt": now}},
+	}
+}
+
+// fairness returns the settings of the weighted fair order with the weights of the organizations.
+func fairness(weights map[string]int) dispatch.Fairness {
+	return dispatch.Fairness{
+		Weights:       weights,
+		DefaultWeight: int(config.FairDefaultWeight),
+		AgingPeriod:   config.FairAgingPeriod,
+	}
+}
+
+// getDispatchedJobs returns the jobs of the pool whose workflows were started and hold slots of the pool,
+// paused jobs give their slots to the waiting jobs.
+func getDispatchedJobs(ctx context.Context, poolId string) ([]models.Job, error) {
+	return service.GetJobs(ctx, bson.M{
+		"ResourcePoolId": poolId,
+		"Status":         bson.M{"$in": []string{models.JobStatusQueued, models.JobStatusRunning}},
+		"DispatchedAt":   bson.M{"$ne": time.Time{}},
+	}, nil, 0)
+}
+
+// dispatchPool starts waiting jobs of the pool, oldest first per organization, until the pool is full.
+// A job takes as many slots as its estimated resources need. Jobs that do not fit into the free slots
+// or the remaining share of their job type are held until running jobs finish. The slots are taken
+// from the running count of the pool, which all server instances share.
+func dispatchPool(ctx context.Context, c client.Client, poolId string, waiting []*models.Job, weights map[string]int) error {
+	pool, err := service.GetResourcePool(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	active, err := getDispatchedJobs(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	counts, err := service.GetPoolQueue(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	running := make(map[string]int)
+	typeRunning := make(map[int32]int)
+	for i := range active {
+		slots := pool.JobSlots(active[i].Resources)
+		running[active[i].TenantId] += slots
+		typeRunning[active[i].JobType] += slots
+	}
+
+	// A pool without a scaling limit does not restrict its jobs
+	free := math.MaxInt32
+	if pool.ScalingLimit > 0 {
+		free = int(int64(pool.ScalingLimit) - counts.Running)
+	}
+	fits := func(job *models.Job) bool {
+		slots := pool.JobSlots(job.Resources)
+		typeSlots := pool.JobTypeSlots(job.JobType)
+		return slots <= free && (typeSlots < 0 || typeRunning[job.JobType]+slots <= typeSlots)
+	}
+	queue := dispatch.NewQueue(waiting, running, fairness(weights))
+	now := time.Now()
+	for free > 0 {
+		job := queue.Next(now, fits)
+		if job == nil {
+			break
+		}
+
+		// Another instance may have taken the free slots meanwhile
+		slots := pool.JobSlots(job.Resources)
+		reserved, err := service.ReservePoolSlots(ctx, poolId, int64(slots), int64(pool.ScalingLimit))
+		if err != nil {
+			return err
+		}
+		if !reserved {
+			break
+		}
+
+		// Another instance or a cancellation may have taken the job
+		claimed, err := service.ClaimJobDispatch(ctx, job.JobId, slots)
+		if err != nil || !claimed {
+			if releaseErr := service.ReleasePoolSlots(ctx, poolId, int64(slots)); releaseErr != nil {
+				log.Errorf("Failed to release the slots of job %s: %v", job.JobId, releaseErr)
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// A paused job queued again to resume already has its workflow, it waits for
+		// the next round if the workflow cannot be resumed
+		job.DispatchedAt, job.PoolSlots = time.Now(), slots
+		if job.RunId != "" {
+			if err := resumeWorkflow(ctx, c, job); err != nil {
+				releaseJobDispatch(ctx, job)
+				continue
+			}
+		} else if err := startJob(ctx, c, job); err != nil {
+			releaseIdempotencyKey(ctx, job.TenantId, job.IdempotencyKey, job.JobId)
+			continue
+		}
+		queue.Started(job, slots)
+		typeRunning[job.JobType] += slots
+		free -= slots
+	}
+
+	return nil
+}
+
+// releaseJobDispatch puts the dispatched job back to the waiting jobs of its pool and gives back its slots.
+func releaseJobDispatch(ctx context.Context, job *models.Job) {
+	if err := service.ReleaseJobDispatch(ctx, job.JobId); err != nil {
+		return
+	}
+	job.DispatchedAt = time.Time{}
+	releaseJobSlots(ctx, job)
+}
+
+// releaseJobSlots gives the slots of the finished or paused job to the waiting jobs of its pool.
+// The slots are cleared on the stored job first, so they are only given back once.
+func releaseJobSlots(ctx context.Context, job *models.Job) {
+	if job.PoolSlots <= 0 {
+		return
+	}
+	slots := job.PoolSlots
+	job.PoolSlots = 0
+
+	claimed, err := service.ClaimJobSlotsRelease(ctx, job.JobId)
+	if err != nil || !claimed {
+		return
+	}
+	if err := service.ReleasePoolSlots(ctx, job.ResourcePoolId, int64(slots)); err != nil {
+		log.Errorf("Failed to release the slots of job %s: %v", job.JobId, err)
+	}
+}
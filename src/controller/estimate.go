@@ -0,0 +1,306 @@
+package controller
+
+import (
+	"context"
+	"time"
+	"transform2/config"
+	"transform2/dispatch"
+	"transform2/models"
+	"transform2/rescript"
+	"transform2/service"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.temporal.io/sdk/client"
+)
+
+// jobTypeStats holds the conversion times of recently finished jobs of one job type.
+type jobTypeStats struct {
+	Average time.Duration // Average conversion time of a job
+	PerByte float64       // Conversion seconds per input byte, 0 if the jobs had no input size
+}
+
+// predict returns the expected conversion time of a job with the given input size.
+func (s *jobTypeStats) predict(size int64) time.Duration {
+	if size > 0 && s.PerByte > 0 {
+		return time.Duration(float64(size) * s.PerByte * float64(time.Second))
+	}
+	return s.Average
+}
+
+// loadJobTypeStats computes the conversion times of the last completed jobs of the job type.
+func loadJobTypeStats(ctx context.Context, jobType int32) (*jobTypeStats, error) {
+	filter := bson.M{
+		"JobType":   jobType,
+		"Status":    bson.M{"$in": []string{models.JobStatusSucceeded, models.JobStatusPartiallyFailed}},
+		"StartedAt": bson.M{"$gt": time.Time{}},
+	}
+	jobs, err := service.GetJobs(ctx, filter, bson.D{{Key: "FinishedAt", Value: -1}}, config.EstimateHistory)
+	if err != nil {
+		return nil, err
+	}
+
+	var stats jobTypeStats
+	var total, sized time.Duration
+	var bytes int64
+	for _, job := range jobs {
+		duration := job.FinishedAt.Sub(job.StartedAt)
+		total += duration
+		if job.InputSize > 0 {
+			sized += duration
+			bytes += job.InputSize
+		}
+	}
+
+	if len(jobs) > 0 {
+		stats.Average = total / time.Duration(len(jobs))
+	}
+	if bytes > 0 {
+		stats.PerByte = sized.Seconds() / float64(bytes)
+	}
+
+	return &stats, nil
+}
+
+// estimateResources estimates the resources of the job with the ReScript of its job type. Without
+// a script, or if the script fails for the job, the conversion time is predicted from the recently
+// finished jobs of the job type, the CPU and memory stay unknown. It returns nil if nothing is known.
+func estimateResources(ctx context.Context, entry *jobTypeEntry, job *models.Job) *models.Resources {
+	if entry.estimator != nil {
+		estimate, err := entry.estimator.Estimate(rescript.Input{
+			Size:       job.InputSize,
+			Extension:  job.SourceFormat,
+			Parameters: rescript.ParseParameters(job.Parameters),
+		})
+		if err == nil {
+			return &models.Resources{
+				CpuCores:        estimate.Cpu,
+				MemoryMB:        estimate.MemoryMB,
+				DurationSeconds: int64(estimate.Duration / time.Second),
+				Source:          models.EstimateSourceScript,
+			}
+		}
+		log.Warnf("ReScript of Job Type %d failed for job %s, using the history: %v", job.JobType, job.JobId, err)
+	}
+
+	if entry.stats == nil {
+		stats, err := loadJobTypeStats(ctx, job.JobType)
+		if err != nil {
+			log.Warnf("Failed to load the history of Job Type %d: %v", job.JobType, err)
+			return nil
+		}
+		entry.stats = stats
+	}
+	duration := entry.stats.predict(job.InputSize)
+	if duration <= 0 {
+		return nil
+	}
+	return &models.Resources{DurationSeconds: int64(duration / time.Second), Source: models.EstimateSourceHistory}
+}
+
+// GetJobQueueInfo returns the queue position of the job and predicts when it starts and how long it converts.
+func GetJobQueueInfo(ctx context.Context, c client.Client, headers framework.CommonHeaders, req *services.C2S_GetJobQueueInfoReq) (*services.S2C_GetJobQueueInfoRpn, error) {
+
+	log.Infof("Controller for Get Job Queue Info")
+
+	job, err := getTenantJob(ctx, headers, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsJobFinished(job.Status) {
+		if err := SyncJob(ctx, c, job); err != nil {
+			log.Errorf("Failed to sync job %s with its workflow: %v", job.JobId, err)
+		}
+	}
+
+	// Jobs estimated by the ReScript of their job type keep their estimate, the others are predicted
+	// from the history. Stats are loaded once per job type, the queue may hold jobs of different types
+	statsByType := make(map[int32]*jobTypeStats)
+	predict := func(j *models.Job) (time.Duration, error) {
+		if j.Resources != nil && j.Resources.Source == models.EstimateSourceScript && j.Resources.DurationSeconds > 0 {
+			return time.Duration(j.Resources.DurationSeconds) * time.Second, nil
+		}
+		stats, ok := statsByType[j.JobType]
+		if !ok {
+			var err error
+			if stats, err = loadJobTypeStats(ctx, j.JobType); err != nil {
+				return 0, err
+			}
+			statsByType[j.JobType] = stats
+		}
+		return stats.predict(j.InputSize), nil
+	}
+
+	conversion, err := predict(job)
+	if err != nil {
+		return nil, err
+	}
+
+	running, err := service.GetJobs(ctx, bson.M{"TaskQueue": job.TaskQueue, "Status": models.JobStatusRunning}, nil, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	queueLength, err := service.CountJobs(ctx, bson.M{"TaskQueue": job.TaskQueue, "Status": models.JobStatusQueued})
+	if err != nil {
+		return nil, err
+	}
+
+	info := &services.JobQueueInfo{
+		JobId:             job.JobId,
+		TaskQueue:         job.TaskQueue,
+		QueueLength:       int32(queueLength),
+		Running:           int32(len(running)),
+		ConversionSeconds: int64(conversion.Seconds()),
+		EstimateSource:    models.EstimateSourceHistory,
+	}
+	if job.Resources != nil {
+		info.CpuCores, info.MemoryMB = job.Resources.CpuCores, job.Resources.MemoryMB
+		if job.Resources.Source == models.EstimateSourceScript && job.Resources.DurationSeconds > 0 {
+			info.EstimateSource = models.EstimateSourceScript
+		}
+	}
+
+	now := time.Now()
+	switch {
+	case IsJobFinished(job.Status):
+		if !job.StartedAt.IsZero() {
+			info.QueueSeconds = int64(job.StartedAt.Sub(job.CreatedAt).Seconds())
+			info.ConversionSeconds = int64(job.FinishedAt.Sub(job.StartedAt).Seconds())
+			info.PredictedStartAt = job.StartedAt.Format(time.RFC3339)
+		}
+		info.PredictedFinishAt = job.FinishedAt.Format(time.RFC3339)
+	case job.Status == models.JobStatusScheduled:
+		// A recurring job never queues itself, its next run starts on time
+		if !job.NextRunAt.IsZero() {
+			info.PredictedStartAt = job.NextRunAt.Format(time.RFC3339)
+			info.PredictedFinishAt = job.NextRunAt.Add(conversion).Format(time.RFC3339)
+		}
+	case job.Status == models.JobStatusPaused:
+		// A paused job holds no slot and does not advance, it has no prediction until it is resumed
+		if !job.StartedAt.IsZero() {
+			info.QueueSeconds = int64(job.StartedAt.Sub(job.CreatedAt).Seconds())
+			info.PredictedStartAt = job.StartedAt.Format(time.RFC3339)
+		}
+	case job.Status == models.JobStatusRunning:
+		info.QueueSeconds = int64(job.StartedAt.Sub(job.CreatedAt).Seconds())
+		info.PredictedStartAt = job.StartedAt.Format(time.RFC3339)
+		info.PredictedFinishAt = now.Add(remainingTime(job, conversion, now)).Format(time.RFC3339)
+	default:
+		// The job starts once the running jobs and the jobs queued before it are converted,
+		// the work is shared by as many workers as jobs are running right now
+		ahead, err := jobsAhead(ctx, job, now)
+		if err != nil {
+			return nil, err
+		}
+
+		var work time.Duration
+		for i := range running {
+			duration, err := predict(&running[i])
+			if err != nil {
+				return nil, err
+			}
+			work += remainingTime(&running[i], duration, now)
+		}
+		for i := range ahead {
+			duration, err := predict(&ahead[i])
+			if err != nil {
+				return nil, err
+			}
+			work += duration
+		}
+
+		workers := len(running)
+		if workers == 0 {
+			workers = 1
+		}
+		wait := work / time.Duration(workers)
+		// A delayed job does not start before its start time
+		if delay := job.NotBefore.Sub(now); delay > wait {
+			wait = delay
+		}
+
+		info.Position = int32(len(ahead) + 1)
+		info.QueueSeconds = int64(wait.Seconds())
+		info.PredictedStartAt = now.Add(wait).Format(time.RFC3339)
+		info.PredictedFinishAt = now.Add(wait + conversion).Format(time.RFC3339)
+	}
+
+	return &services.S2C_GetJobQueueInfoRpn{
+		StatusCode: 200,
+		Message:    "Job Queue Info Found",
+		Data:       info,
+	}, nil
+}
+
+// remainingTime predicts how long the running job still converts. Once the job reported progress
+// the time it took so far is extrapolated, before that the predicted conversion time is used.
+func remainingTime(job *models.Job, conversion time.Duration, now time.Time) time.Duration {
+	elapsed := now.Sub(job.StartedAt)
+	if job.Progress > 0 && job.Progress < 100 && elapsed > 0 {
+		return time.Duration(float64(elapsed) * float64(100-job.Progress) / float64(job.Progress))
+	}
+	if remaining := conversion - elapsed; remaining > 0 {
+		return remaining
+	}
+	return 0
+}
+
+// jobsAhead returns the queued jobs that start before the job. Workflows already started wait in their
+// task queue in the order they were started. Jobs waiting for the slots of a shared pool come after them,
+// in the weighted fair order the dispatcher hands out the slots in.
+func jobsAhead(ctx context.Context, job *models.Job, now time.Time) ([]models.Job, error) {
+	dispatched := bson.M{"$ne": time.Time{}}
+	if !job.DispatchedAt.IsZero() {
+		dispatched["$lt"] = job.DispatchedAt
+	}
+	ahead, err := service.GetJobs(ctx, bson.M{
+		"TaskQueue":    job.TaskQueue,
+		"Status":       models.JobStatusQueued,
+		"DispatchedAt": dispatched,
+		"NotBefore":    bson.M{"$not": bson.M{"$gt": now}},
+	}, nil, 0)
+	if err != nil || job.ResourcePoolId == "" || !job.DispatchedAt.IsZero() {
+		return ahead, err
+	}
+
+	pool, err := service.GetResourcePool(ctx, job.ResourcePoolId)
+	if err != nil {
+		return nil, err
+	}
+	filter := waitingJobsFilter(now)
+	filter["ResourcePoolId"] = job.ResourcePoolId
+	waiting, err := service.GetJobs(ctx, filter, bson.D{{Key: "CreatedAt", Value: 1}}, 0)
+	if err != nil {
+		return nil, err
+	}
+	active, err := getDispatchedJobs(ctx, job.ResourcePoolId)
+	if err != nil {
+		return nil, err
+	}
+	weights, err := service.GetTenantWeights(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	running := make(map[string]int)
+	for i := range active {
+		running[active[i].TenantId] += pool.JobSlots(active[i].Resources)
+	}
+	queued := make([]*models.Job, len(waiting))
+	for i := range waiting {
+		queued[i] = &waiting[i]
+	}
+
+	// Every waiting job is handed out in turn as if the pool had a free slot for it,
+	// a delayed job is not in the queue yet and waits for all of them
+	queue := dispatch.NewQueue(queued, running, fairness(weights))
+	fits := func(*models.Job) bool { return true }
+	for next := queue.Next(now, fits); next != nil && next.JobId != job.JobId; next = queue.Next(now, fits) {
+		ahead = append(ahead, *next)
+		queue.Started(next, pool.JobSlots(next.Resources))
+	}
+	return ahead, nil
+}
@@ -5,7 +5,10 @@ import (
 	"gitlab.zixel.cn/go/framework"
 	"gitlab.zixel.cn/go/framework/logger"
 	"strconv"
+	"transform2/config"
 	"transform2/models"
+	"transform2/rescript"
+	"transform2/scscript"
 	"transform2/service"
 	"transform2/services"
 )
@@ -16,6 +19,21 @@ func AddJobType(ctx context.Context, req *services.C2S_AddJobTypeReqT) (*service
 
 	log.Infof("Controller Starting for Add Job Type")
 
+	retryPolicy, err := newRetryPolicy(req.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+	timeouts, err := newTimeouts(req.Timeouts)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := scscript.Compile(req.ScScript); err != nil {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Invalid ScScript: "+err.Error())
+	}
+	if _, err := rescript.Compile(req.ReScript); err != nil {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Invalid ReScript: "+err.Error())
+	}
+
 	intID := GenerateID()          // Invoke the Function to get a Unique ID
 	id := strconv.Itoa(int(intID)) //Convert the integer to string to use as ID
 
@@ -28,6 +46,10 @@ func AddJobType(ctx context.Context, req *services.C2S_AddJobTypeReqT) (*service
 		ScScript:            req.ScScript,
 		JeScript:            req.JeScript,
 		FixedParameters:     req.FixedParameters,
+		TaskQueue:           req.TaskQueue,
+		Workflow:            req.Workflow,
+		RetryPolicy:         retryPolicy,
+		Timeouts:            timeouts,
 	}
 
 	// Validate the Request before sending it to the Database
@@ -38,7 +60,7 @@ func AddJobType(ctx context.Context, req *services.C2S_AddJobTypeReqT) (*service
 	}
 
 	// Add the JobType to the Database
-	_, err := service.AddJobType(ctx, newJobType)
+	_, err = service.AddJobType(ctx, newJobType)
 	if err != nil {
 		return nil, err
 	}
@@ -92,6 +114,10 @@ func GetJobType(ctx context.Context, req *services.C2S_GetJobTypeReqT) (*service
 		ImageUrl:            res.ImageUrl,
 		JeScript:            res.JeScript,
 		ReScript:            res.ReScript,
+		TaskQueue:           res.TaskQueue,
+		Workflow:            res.Workflow,
+		RetryPolicy:         newRetryPolicyMsg(res.RetryPolicy),
+		Timeouts:            newTimeoutsMsg(res.Timeouts),
 	}
 	rpn.StatusCode = "200"
 	rpn.Message = "Job Type Found"
@@ -122,6 +148,21 @@ func SetJobType(ctx context.Context, req *services.C2S_SetJobTypeReqT) (*service
 
 	log.Infof("Starting the Controller for Update Job Type")
 
+	retryPolicy, err := newRetryPolicy(req.RetryPolicy)
+	if err != nil {
+		return nil, err
+	}
+	timeouts, err := newTimeouts(req.Timeouts)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := scscript.Compile(req.ScScript); err != nil {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Invalid ScScript: "+err.Error())
+	}
+	if _, err := rescript.Compile(req.ReScript); err != nil {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Invalid ReScript: "+err.Error())
+	}
+
 	// Parse the Request and Set the appropriate Structs to Update in the Database
 	updatedJobType := &models.JobType{
 		JobTypeId:       req.JobTypeId,
@@ -130,6 +171,10 @@ func SetJobType(ctx context.Context, req *services.C2S_SetJobTypeReqT) (*service
 		ScScript:        req.ScScript,
 		JeScript:        req.JeScript,
 		FixedParameters: req.FixedParameters,
+		TaskQueue:       req.TaskQueue,
+		Workflow:        req.Workflow,
+		RetryPolicy:     retryPolicy,
+		Timeouts:        timeouts,
 	}
 
 	// Validate the Request before updating it in the Database
@@ -450,3 +495,82 @@ func QueryJobType(ctx context.Context, req *services.C2S_QueryJobTypeReqT) (*ser
 	service.RemoveJobTypeDuplicates(ctx, rpn.JobTypes)
 	return rpn, nil
 }
+
+// newRetryPolicy converts and validates the retry policy of a job type request, nil stays nil.
+func newRetryPolicy(msg *services.RetryPolicy) (*models.RetryPolicy, error) {
+	if msg == nil {
+		return nil, nil
+	}
+
+	for _, category := range msg.RetryableCategories {
+		if _, ok := config.ErrorCategoryCodes[category]; !ok {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Unknown Error Category "+category)
+		}
+	}
+	if msg.MaxAttempts < 0 || msg.InitialIntervalMs < 0 || msg.MaximumIntervalMs < 0 || (msg.BackoffCoefficient != 0 && msg.BackoffCoefficient < 1) {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Invalid Retry Policy")
+	}
+
+	return &models.RetryPolicy{
+		MaxAttempts:         msg.MaxAttempts,
+		InitialIntervalMs:   msg.InitialIntervalMs,
+		BackoffCoefficient:  msg.BackoffCoefficient,
+		MaximumIntervalMs:   msg.MaximumIntervalMs,
+		RetryableCategories: msg.RetryableCategories,
+	}, nil
+}
+
+// newRetryPolicyMsg converts the retry policy of a job type to its message, nil stays nil.
+func newRetryPolicyMsg(policy *models.RetryPolicy) *services.RetryPolicy {
+	if policy == nil {
+		return nil
+	}
+
+	return &services.RetryPolicy{
+		MaxAttempts:         policy.MaxAttempts,
+		InitialIntervalMs:   policy.InitialIntervalMs,
+		BackoffCoefficient:  policy.BackoffCoefficient,
+		MaximumIntervalMs:   policy.MaximumIntervalMs,
+		RetryableCategories: policy.RetryableCategories,
+	}
+}
+
+// newTimeouts converts and validates the timeouts of a job type request, nil stays nil.
+func newTimeouts(msg *services.Timeouts) (*models.Timeouts, error) {
+	if msg == nil {
+		return nil, nil
+	}
+
+	if msg.ScheduleToStartSeconds < 0 || msg.StartToCloseSeconds < 0 || msg.ScheduleToCloseSeconds < 0 ||
+		msg.HeartbeatSeconds < 0 || msg.JobSeconds < 0 || msg.SecondsPerMB < 0 {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Timeouts must not be negative")
+	}
+	if msg.ScheduleToCloseSeconds > 0 && msg.StartToCloseSeconds > msg.ScheduleToCloseSeconds {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Start To Close Timeout must not exceed the Schedule To Close Timeout")
+	}
+
+	return &models.Timeouts{
+		ScheduleToStartSeconds: msg.ScheduleToStartSeconds,
+		StartToCloseSeconds:    msg.StartToCloseSeconds,
+		ScheduleToCloseSeconds: msg.ScheduleToCloseSeconds,
+		HeartbeatSeconds:       msg.HeartbeatSeconds,
+		JobSeconds:             msg.JobSeconds,
+		SecondsPerMB:           msg.SecondsPerMB,
+	}, nil
+}
+
+// newTimeoutsMsg converts the timeouts of a job type to their message, nil stays nil.
+func newTimeoutsMsg(timeouts *models.Timeouts) *services.Timeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	return &services.Timeouts{
+		ScheduleToStartSeconds: timeouts.ScheduleToStartSeconds,
+		StartToCloseSeconds:    timeouts.StartToCloseSeconds,
+		ScheduleToCloseSeconds: timeouts.ScheduleToCloseSeconds,
+		HeartbeatSeconds:       timeouts.HeartbeatSeconds,
+		JobSeconds:             timeouts.JobSeconds,
+		SecondsPerMB:           timeouts.SecondsPerMB,
+	}
+}
@@ -0,0 +1,123 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+	"transform2/config"
+	"transform2/models"
+	"transform2/service"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+)
+
+// messageBusConnection is the key of the message bus in the grpc.connections configuration.
+const messageBusConnection = "messagebus"
+
+// outboxLease is how long a server instance may publish a claimed outbox before another one takes over.
+const outboxLease = time.Minute
+
+// newOutboxEvent returns the event of the current state of the job, it is stored with the change.
+func newOutboxEvent(job *models.Job, eventType string) models.OutboxEvent {
+	return models.OutboxEvent{
+		EventId:       strconv.Itoa(int(GenerateID())),
+		Type:          eventType,
+		JobId:         job.JobId,
+		TenantId:      job.TenantId,
+		AppId:         job.AppId,
+		UserId:        job.UserId,
+		JobType:       job.JobType,
+		Status:        job.Status,
+		Progress:      job.Progress,
+		Message:       job.Message,
+		ErrorCategory: job.ErrorCategory,
+		OccurredAt:    time.Now(),
+	}
+}
+
+// PublishJobEvents publishes the events in the outboxes of the jobs on the progress topic until the
+// context is done. Events of a job are published in order and removed from its outbox once the
+// message bus accepted them, a broker that is down only delays them. Consumers may receive an event
+// twice if a server instance stops between publishing and removing it.
+func PublishJobEvents(ctx context.Context, interval time.Duration) {
+	log.Infof("Job event publisher started, topic %s, interval %v", config.TopicJobProgress, interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Job event publisher stopped")
+			return
+		case <-ticker.C:
+		}
+		publishOutboxes(ctx)
+	}
+}
+
+// publishOutboxes publishes the due outboxes until none is left or the message bus fails.
+func publishOutboxes(ctx context.Context) {
+	secretKey, ok := config.TopicJobProgressMap[config.ServiceName].(string)
+	if !ok {
+		log.Debugf("No key of service %s configured for topic %s, job events stay in the outbox", config.ServiceName, config.TopicJobProgress)
+		return
+	}
+	conn := framework.GetGrpcConnection(messageBusConnection)
+	if conn == nil {
+		log.Debugf("No %s connection configured, job events stay in the outbox", messageBusConnection)
+		return
+	}
+	client := services.NewProducerMsgServerClient(conn)
+
+	for ctx.Err() == nil {
+		job, err := service.ClaimJobOutbox(ctx, time.Now().Add(outboxLease))
+		if err != nil || job == nil {
+			return
+		}
+
+		var published []string
+		for _, event := range job.Outbox {
+			if err = publishJobEvent(ctx, client, secretKey, &event); err != nil {
+				log.Warnf("Failed to publish event %s of job %s: %v", event.EventId, job.JobId, err)
+				break
+			}
+			published = append(published, event.EventId)
+		}
+
+		if releaseErr := service.ReleaseJobOutbox(ctx, job.JobId, published); releaseErr != nil {
+			return
+		}
+		// The message bus is down, retry on the next tick
+		if err != nil {
+			return
+		}
+	}
+}
+
+// publishJobEvent sends the event to the progress topic, the event type is sent as catalog.
+func publishJobEvent(ctx context.Context, client services.ProducerMsgServerClient, secretKey string, event *models.OutboxEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	rpn, err := client.ProducerMsg(ctx, &services.MsgRequest{
+		Namespace: config.TopicJobProgress,
+		Catalogs:  event.Type,
+		Service:   config.ServiceName,
+		SecretKey: secretKey,
+		Body:      string(body),
+	})
+	if err != nil {
+		return err
+	}
+	// The message bus answers 1 for success and 0 for failure
+	if rpn.Code != 1 {
+		return fmt.Errorf("message bus rejected the event: %s", rpn.Msg)
+	}
+	return nil
+}
@@ -0,0 +1,316 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+	"transform2/config"
+	"transform2/dispatch"
+	"transform2/models"
+	"transform2/service"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// AddQuota adds a quota for an organization or a user.
+func AddQuota(ctx context.Context, req *services.C2S_AddQuotaReq) (*services.S2C_AddQuotaRpn, error) {
+
+	log.Infof("Controller for Add Quota")
+
+	quota, err := newQuota(req.Quota)
+	if err != nil {
+		return nil, err
+	}
+	quota.QuotaId = strconv.Itoa(int(GenerateID()))
+	quota.CreatedAt = quota.UpdatedAt
+
+	if err := service.AddQuota(ctx, quota); err != nil {
+		return nil, err
+	}
+
+	return &services.S2C_AddQuotaRpn{
+		StatusCode: 200,
+		Message:    "Quota Added",
+		QuotaId:    quota.QuotaId,
+	}, nil
+}
+
+// RemoveQuota removes a quota.
+func RemoveQuota(ctx context.Context, req *services.C2S_RemoveQuotaReq) (*services.S2C_RemoveQuotaRpn, error) {
+
+	log.Infof("Controller for Remove Quota")
+
+	if err := service.RemoveQuota(ctx, req.QuotaId); err != nil {
+		return nil, err
+	}
+
+	return &services.S2C_RemoveQuotaRpn{
+		StatusCode: 200,
+		Message:    "Quota " + req.QuotaId + " Removed",
+	}, nil
+}
+
+// SetQuota replaces the settings of a quota, the used conversions are kept.
+func SetQuota(ctx context.Context, req *services.C2S_SetQuotaReq) (*services.S2C_SetQuotaRpn, error) {
+
+	log.Infof("Controller for Set Quota")
+
+	quota, err := newQuota(req.Quota)
+	if err != nil {
+		return nil, err
+	}
+	if quota.QuotaId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Quota ID is required")
+	}
+
+	if err := service.UpdateQuota(ctx, quota); err != nil {
+		return nil, err
+	}
+
+	return &services.S2C_SetQuotaRpn{
+		StatusCode: 200,
+		Message:    "Quota " + quota.QuotaId + " Updated",
+	}, nil
+}
+
+// GetQuota returns a quota.
+func GetQuota(ctx context.Context, req *services.C2S_GetQuotaReq) (*services.S2C_GetQuotaRpn, error) {
+
+	log.Infof("Controller for Get Quota")
+
+	quota, err := service.GetQuota(ctx, req.QuotaId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &services.S2C_GetQuotaRpn{
+		StatusCode: 200,
+		Message:    "Quota Found",
+		Quota:      newQuotaMsg(quota),
+	}, nil
+}
+
+// QueryQuota returns the quotas of an organization or a user.
+func QueryQuota(ctx context.Context, req *services.C2S_QueryQuotaReq) (*services.S2C_QueryQuotaRpn, error) {
+
+	log.Infof("Controller for Query Quota")
+
+	filter := bson.M{}
+	if req.TenantId != "" {
+		filter["TenantId"] = req.TenantId
+	}
+	if req.UserId != "" {
+		filter["UserId"] = req.UserId
+	}
+
+	quotas, err := service.QueryQuotas(ctx, filter, int64(req.Skip), int64(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	rpn := &services.S2C_QueryQuotaRpn{
+		StatusCode: 200,
+		Message:    fmt.Sprintf("%d Quotas Found", len(quotas)),
+	}
+	for i := range quotas {
+		rpn.Quotas = append(rpn.Quotas, newQuotaMsg(&quotas[i]))
+	}
+
+	return rpn, nil
+}
+
+// newQuota validates the quota message and converts it to the stored quota.
+func newQuota(msg *services.Quota) (*models.Quota, error) {
+	if msg == nil {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Quota is required")
+	}
+	if msg.TenantId == "" && msg.UserId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Quota needs an Organization or a User")
+	}
+	if msg.AvailableConversions < models.QuotaUnlimited {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Available Conversions must be -1 or more")
+	}
+
+	quota := &models.Quota{
+		QuotaId:              msg.QuotaId,
+		TenantId:             msg.TenantId,
+		UserId:               msg.UserId,
+		AvailableConversions: msg.AvailableConversions,
+		JobTypes:             msg.JobTypes,
+		IsEnable:             msg.IsEnable,
+		UpdatedAt:            time.Now(),
+	}
+	for _, format := range msg.TargetFormats {
+		quota.TargetFormats = append(quota.TargetFormats, models.NormalizeFormat(format))
+	}
+
+	var err error
+	if quota.ValidFrom, err = parseQuotaTime(msg.ValidFrom); err != nil {
+		return nil, err
+	}
+	if quota.ValidUntil, err = parseQuotaTime(msg.ValidUntil); err != nil {
+		return nil, err
+	}
+	if !quota.ValidFrom.IsZero() && !quota.ValidUntil.IsZero() && !quota.ValidFrom.Before(quota.ValidUntil) {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Quota must be valid from before it is valid until")
+	}
+
+	return quota, nil
+}
+
+// parseQuotaTime parses an RFC 3339 time of a quota message, an empty value is the zero time.
+func parseQuotaTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Invalid time "+value+", expected RFC 3339")
+	}
+	return t, nil
+}
+
+// newQuotaMsg converts a stored quota to its message.
+func newQuotaMsg(quota *models.Quota) *services.Quota {
+	msg := &services.Quota{
+		QuotaId:              quota.QuotaId,
+		TenantId:             quota.TenantId,
+		UserId:               quota.UserId,
+		AvailableConversions: quota.AvailableConversions,
+		UsedConversions:      quota.UsedConversions,
+		JobTypes:             quota.JobTypes,
+		TargetFormats:        quota.TargetFormats,
+		IsEnable:             quota.IsEnable,
+	}
+	if !quota.ValidFrom.IsZero() {
+		msg.ValidFrom = quota.ValidFrom.Format(time.RFC3339)
+	}
+	if !quota.ValidUntil.IsZero() {
+		msg.ValidUntil = quota.ValidUntil.Format(time.RFC3339)
+	}
+	return msg
+}
+
+// applyQuotas returns the jobs allowed by the quotas of the caller and takes one conversion
+// per job from every limited quota, recurring jobs are only checked. Every enabled quota of the
+// organization and of the user that is valid now must allow a job, callers without such quotas
+// are not limited, callers without an organization are rejected as their quotas are unknown.
+// Rejected jobs carry the QuotaExceeded category and release their idempotency keys and queue
+// places, with allOrNothing a single rejected job rejects the whole batch.
+func applyQuotas(ctx context.Context, headers framework.CommonHeaders, subs []*jobSubmission, allOrNothing bool) []*jobSubmission {
+	if len(subs) == 0 {
+		return subs
+	}
+
+	loaded, err := service.GetQuotasOf(ctx, headers.TenantId, headers.ZixelUserId)
+	if err != nil {
+		for _, sub := range subs {
+			sub.err = err
+			sub.release(ctx, headers)
+		}
+		return nil
+	}
+
+	// Quotas not valid yet or expired do not apply
+	now := time.Now()
+	var quotas []models.Quota
+	for i := range loaded {
+		if loaded[i].ValidAt(now) {
+			quotas = append(quotas, loaded[i])
+		}
+	}
+	if len(quotas) == 0 {
+		return subs
+	}
+
+	for _, sub := range subs {
+		for i := range quotas {
+			if reason := quotas[i].Check(sub.job.JobType, sub.job.TargetFormats); reason != "" {
+				sub.err = config.NewJobError(models.ErrorQuotaExceeded, reason)
+				break
+			}
+		}
+	}
+
+	// Jobs past the conversions left in a quota are rejected
+	allowed, _ := dispatch.SplitBatch(subs, (*jobSubmission).rejected, false)
+	charged := chargedSubmissions(allowed)
+	for i := range quotas {
+		available := quotas[i].AvailableConversions
+		if available == models.QuotaUnlimited || int64(len(charged)) <= available {
+			continue
+		}
+		for _, sub := range charged[available:] {
+			sub.err = config.NewJobError(models.ErrorQuotaExceeded, "Quota "+quotas[i].QuotaId+" has no conversions left")
+		}
+		charged = charged[:available]
+	}
+
+	allowed, rejected := dispatch.SplitBatch(subs, (*jobSubmission).rejected, allOrNothing)
+	for _, sub := range rejected {
+		if sub.err == nil {
+			sub.err = config.NewJobError(models.ErrorQuotaExceeded, "Rejected with a Job of the Batch not allowed by the Quota")
+		}
+	}
+
+	// Another submission may have used the conversions since the quotas were loaded
+	if charged = chargedSubmissions(allowed); len(charged) > 0 {
+		var consumed []string
+		for i := range quotas {
+			ok, err := service.ConsumeQuota(ctx, quotas[i].QuotaId, int64(len(charged)))
+			if err == nil && !ok {
+				err = config.NewJobError(models.ErrorQuotaExceeded, "Quota "+quotas[i].QuotaId+" has no conversions left")
+			}
+			if err != nil {
+				for _, quotaId := range consumed {
+					refundQuota(ctx, quotaId, int64(len(charged)))
+				}
+				for _, sub := range allowed {
+					sub.err = err
+				}
+				rejected, allowed = append(rejected, allowed...), nil
+				break
+			}
+			consumed = append(consumed, quotas[i].QuotaId)
+		}
+		for _, sub := range charged {
+			sub.job.Quotas = consumed
+		}
+	}
+
+	for _, sub := range rejected {
+		sub.release(ctx, headers)
+	}
+
+	return allowed
+}
+
+// chargedSubmissions returns the submissions that take a conversion, a recurring job takes none,
+// each of its runs is charged when it is submitted.
+func chargedSubmissions(subs []*jobSubmission) []*jobSubmission {
+	var charged []*jobSubmission
+	for _, sub := range subs {
+		if sub.job.Status != models.JobStatusScheduled {
+			charged = append(charged, sub)
+		}
+	}
+	return charged
+}
+
+// refundJobQuotas returns the conversions the job took to its quotas, for a job that was never started.
+func refundJobQuotas(ctx context.Context, job *models.Job) {
+	for _, quotaId := range job.Quotas {
+		refundQuota(ctx, quotaId, 1)
+	}
+	job.Quotas = nil
+}
+
+// refundQuota returns conversions to a quota, failures are only logged.
+func refundQuota(ctx context.Context, quotaId string, conversions int64) {
+	if err := service.RefundQuota(ctx, quotaId, conversions); err != nil {
+		log.Errorf("Failed to refund %d conversions to quota %s: %v", conversions, quotaId, err)
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"gitlab.zixel.cn/go/framework"
 	"strconv"
+	"time"
 	"transform2/models"
 	"transform2/service"
 	"transform2/services"
@@ -28,6 +29,12 @@ func AddResourcePool(ctx context.Context, req *services.C2S_AddResourcePoolReqT)
 		QueueLimit:     req.QueueLimit,
 		Fixed:          req.Fixed,
 		DefaultTaskSet: req.DefaultTaskSet,
+		ResourceLimits: make(map[string]*models.ResourceLimitOfTask),
+		SlotCpu:        req.SlotCpu,
+		SlotMemoryMB:   req.SlotMemoryMB,
+	}
+	if newResourcePool.SlotCpu < 0 || newResourcePool.SlotMemoryMB < 0 {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Slot Size must not be negative")
 	}
 
 	// Set ResourceLimits for each job type
@@ -69,6 +76,11 @@ func RemoveResourcePool(ctx context.Context, req *services.C2S_RemoveResourcePoo
 	if err := service.RemoveResourcePool(ctx, req.PoolId); err != nil {
 		return &services.C2S_RemoveResourcePoolRpnT{StatusCode: "404", Message: err.Error()}, nil
 	}
+
+	// A removed pool is no longer charged
+	if _, err := service.StopPoolUptime(ctx, req.PoolId, ""); err != nil {
+		log.Errorf("Failed to stop the uptime of removed pool %s: %v", req.PoolId, err)
+	}
 	ss := "Resource Pool with ID: " + req.PoolId + " is Deleted"
 	res := &services.C2S_RemoveResourcePoolRpnT{StatusCode: "200", Message: ss}
 
@@ -90,6 +102,12 @@ func SetResourcePool(ctx context.Context, req *services.C2S_SetResourcePoolReqT)
 		QueueLimit:      req.QueueLimit,
 		Fixed:           req.Fixed,
 		DefaultTaskSet:  req.DefaultTaskSet,
+		ResourceLimits:  make(map[string]*models.ResourceLimitOfTask),
+		SlotCpu:         req.SlotCpu,
+		SlotMemoryMB:    req.SlotMemoryMB,
+	}
+	if updateRP.SlotCpu < 0 || updateRP.SlotMemoryMB < 0 {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Slot Size must not be negative")
 	}
 
 	// Set ResourceLimit
@@ -99,7 +117,7 @@ func SetResourcePool(ctx context.Context, req *services.C2S_SetResourcePoolReqT)
 		scalingLimit := rl.ScalingLimit
 
 		updateRP.ResourceLimits[jobTypeId] = &models.ResourceLimitOfTask{
-			//JobTypeId:    rl.JobTypeId,
+			JobTypeId:    jobTypeId,
 			ScalingLimit: scalingLimit,
 		}
 	}
@@ -171,6 +189,9 @@ func GetResourcePool(ctx context.Context, req *services.C2S_GetResourcePoolReqT)
 		QueueLimit:      res.QueueLimit,
 		Fixed:           res.Fixed,
 		DefaultTaskSet:  res.DefaultTaskSet,
+		ResourceLimits:  make(map[string]*services.ResourceLimitOfTask),
+		SlotCpu:         res.SlotCpu,
+		SlotMemoryMB:    res.SlotMemoryMB,
 	}
 
 	// Set ResourceLimit
@@ -270,6 +291,9 @@ func QueryResourcePool(ctx context.Context, req *services.C2S_QueryResourcePoolR
 				QueueLimit:      pool.QueueLimit,
 				Fixed:           pool.Fixed,
 				DefaultTaskSet:  pool.DefaultTaskSet,
+				ResourceLimits:  make(map[string]*services.ResourceLimitOfTask),
+				SlotCpu:         pool.SlotCpu,
+				SlotMemoryMB:    pool.SlotMemoryMB,
 			}
 
 			for _, rl := range pool.ResourceLimits {
@@ -294,3 +318,61 @@ func QueryResourcePool(ctx context.Context, req *services.C2S_QueryResourcePoolR
 
 	return rpn, nil
 }
+
+// StartResourcePool starts the uptime of an exclusive pool, the organization starting it is charged for the uptime.
+func StartResourcePool(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_StartResourcePoolReqT) (*services.C2S_StartResourcePoolRpnT, error) {
+
+	log.Infof("Controller for Start Resource Pool")
+
+	if headers.TenantId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required to start a Resource Pool")
+	}
+
+	pool, err := service.GetResourcePool(ctx, req.PoolId)
+	if err != nil {
+		return nil, err
+	}
+	if pool.IsShared {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Resource Pool "+req.PoolId+" is shared, only exclusive pools are started")
+	}
+
+	uptime, started, err := service.StartPoolUptime(ctx, req.PoolId, headers.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if !started {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Resource Pool "+req.PoolId+" is already started")
+	}
+
+	log.Infof("Resource pool %s started by organization %s", req.PoolId, headers.TenantId)
+	return &services.C2S_StartResourcePoolRpnT{
+		StatusCode: "200",
+		Message:    "Resource Pool Started",
+		StartedAt:  uptime.StartedAt.Format(time.RFC3339),
+	}, nil
+}
+
+// StopResourcePool stops the uptime of an exclusive pool, only the organization that started it stops it.
+func StopResourcePool(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_StopResourcePoolReqT) (*services.C2S_StopResourcePoolRpnT, error) {
+
+	log.Infof("Controller for Stop Resource Pool")
+
+	if headers.TenantId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required to stop a Resource Pool")
+	}
+
+	uptime, err := service.StopPoolUptime(ctx, req.PoolId, headers.TenantId)
+	if err != nil {
+		return nil, err
+	}
+	if uptime == nil {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Resource Pool "+req.PoolId+" is not started by organization "+headers.TenantId)
+	}
+
+	log.Infof("Resource pool %s stopped by organization %s", req.PoolId, headers.TenantId)
+	return &services.C2S_StopResourcePoolRpnT{
+		StatusCode:    "200",
+		Message:       "Resource Pool Stopped",
+		UptimeSeconds: int64(uptime.StoppedAt.Sub(uptime.StartedAt) / time.Second),
+	}, nil
+}
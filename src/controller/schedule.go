@@ -0,0 +1,179 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"time"
+	"transform2/config"
+	"transform2/models"
+	"transform2/service"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
+)
+
+// startJobSchedule creates the Temporal schedule of the recurring job, the job ID is used as schedule ID.
+// Every run of the schedule starts a ScheduledRunWorkflow on config.ScheduleTaskQueue, which submits
+// the run as a job of its own. Runs are not started before NotBefore.
+func startJobSchedule(ctx context.Context, c client.Client, job *models.Job) error {
+	log.Debugf("Creating Schedule %q of Job %s", job.CronSchedule, job.JobId)
+	_, err := c.ScheduleClient().Create(ctx, client.ScheduleOptions{
+		ID: job.JobId,
+		Spec: client.ScheduleSpec{
+			CronExpressions: []string{job.CronSchedule},
+			StartAt:         job.NotBefore,
+		},
+		Action: &client.ScheduleWorkflowAction{
+			ID:                       job.JobId,
+			Workflow:                 ScheduledRunWorkflow,
+			Args:                     []interface{}{job.JobId},
+			TaskQueue:                config.ScheduleTaskQueue,
+			WorkflowExecutionTimeout: scheduledRunTimeout,
+		},
+		Overlap: enums.SCHEDULE_OVERLAP_POLICY_SKIP,
+	})
+	if err != nil {
+		log.Errorf("Failed to create schedule: %v", err)
+		// Temporal validates the cron expression
+		var invalid *serviceerror.InvalidArgument
+		if errors.As(err, &invalid) {
+			return failJobStart(ctx, job, models.ErrorInvalidRequest, err)
+		}
+		return failJobStart(ctx, job, models.ErrorInternal, err)
+	}
+
+	return nil
+}
+
+// syncJobSchedule stores the time of the next run of the recurring job on the job.
+// A schedule that was deleted outside of the service finishes the job as Cancelled.
+func syncJobSchedule(ctx context.Context, c client.Client, job *models.Job) error {
+	describe, err := c.ScheduleClient().GetHandle(ctx, job.JobId).Describe(ctx)
+	if err != nil {
+		var notFound *serviceerror.NotFound
+		if !errors.As(err, &notFound) {
+			return err
+		}
+		job.Status, job.Message, job.ErrorCategory = models.JobStatusCancelled, "Schedule was deleted", models.ErrorCancelled
+		if err := service.FinishJob(ctx, job.JobId, job.Status, job.ErrorCategory, job.Message, newOutboxEvent(job, models.OutboxEventFailed)); err != nil {
+			return err
+		}
+		recordJobEvent(ctx, job)
+		enqueueWebhook(ctx, job)
+		return nil
+	}
+
+	var nextRunAt time.Time
+	if len(describe.Info.NextActionTimes) > 0 {
+		nextRunAt = describe.Info.NextActionTimes[0]
+	}
+	if nextRunAt.Equal(job.NextRunAt) {
+		return nil
+	}
+
+	job.NextRunAt = nextRunAt
+	return service.UpdateJob(ctx, job.JobId, bson.M{"NextRunAt": job.NextRunAt})
+}
+
+// scheduledRunTimeout limits a ScheduledRunWorkflow, it only submits the run.
+const scheduledRunTimeout = 10 * time.Minute
+
+// StartScheduleWorker starts the worker of config.ScheduleTaskQueue in the background,
+// it runs the ScheduledRunWorkflows started by the schedules of the recurring jobs.
+func StartScheduleWorker(c client.Client) error {
+	w := worker.New(c, config.ScheduleTaskQueue, worker.Options{})
+	w.RegisterWorkflow(ScheduledRunWorkflow)
+	w.RegisterActivity(&scheduleActivities{c: c})
+	return w.Start()
+}
+
+// ScheduledRunWorkflow submits a run of the recurring job. The workflow ID, which Temporal makes
+// unique per run, is the idempotency key of the submission, so a retried submission adds no second job.
+func ScheduledRunWorkflow(ctx workflow.Context, scheduleId string) error {
+	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+		StartToCloseTimeout: time.Minute,
+		RetryPolicy:         &temporal.RetryPolicy{MaximumAttempts: 5},
+	})
+	var a *scheduleActivities
+	return workflow.ExecuteActivity(ctx, a.SubmitScheduledRun, scheduleId, workflow.GetInfo(ctx).WorkflowExecution.ID).Get(ctx, nil)
+}
+
+// scheduleActivities are the activities of ScheduledRunWorkflow.
+type scheduleActivities struct {
+	c client.Client
+}
+
+// SubmitScheduledRun submits a run of the recurring job with its request, like any other job the run
+// is admitted to its pool, charged to the quotas of the submitter and waits for the dispatcher.
+// No run is submitted while the previous run did not finish, or after the recurring job finished.
+// Rejected runs are not retried.
+func (a *scheduleActivities) SubmitScheduledRun(ctx context.Context, scheduleId string, runKey string) error {
+	schedule, err := service.GetJob(ctx, scheduleId)
+	if err != nil {
+		return err
+	}
+	if schedule.Status != models.JobStatusScheduled {
+		log.Infof("Job %s is %s, run %s is skipped", scheduleId, schedule.Status, runKey)
+		return nil
+	}
+
+	running, err := service.CountJobs(ctx, bson.M{
+		"ScheduleId": scheduleId,
+		"Status":     bson.M{"$in": []string{models.JobStatusQueued, models.JobStatusRunning, models.JobStatusPaused}},
+	})
+	if err != nil {
+		return err
+	}
+	if running > 0 {
+		log.Infof("Previous run of Job %s did not finish, run %s is skipped", scheduleId, runKey)
+		return nil
+	}
+
+	// The run belongs to the submitter of the recurring job
+	headers := framework.CommonHeaders{
+		TenantId:    schedule.TenantId,
+		AppId:       schedule.AppId,
+		ZixelUserId: schedule.UserId,
+	}
+	sub := &jobSubmission{req: &services.C2S_CreateJobReq{
+		JobType:        schedule.JobType,
+		StorageToken:   schedule.StorageToken,
+		Parameters:     schedule.Parameters,
+		FileSize:       schedule.InputSize,
+		SourceFormat:   schedule.SourceFormat,
+		TargetFormats:  schedule.TargetFormats,
+		CallbackUrl:    schedule.CallbackUrl,
+		IdempotencyKey: runKey,
+	}}
+	if sub.job, sub.err = newJob(ctx, headers, sub.req, make(map[int32]*jobTypeEntry)); sub.err == nil {
+		sub.job.ScheduleId = schedule.JobId
+	}
+
+	if sub = startSubmissions(ctx, a.c, headers, []*jobSubmission{sub}, true)[0]; sub.err != nil {
+		log.Warnf("Run %s of Job %s was rejected: %v", runKey, scheduleId, sub.err)
+		if category := config.ErrorCategory(sub.err); category != models.ErrorInternal {
+			return temporal.NewNonRetryableApplicationError(sub.err.Error(), category, nil)
+		}
+		return sub.err
+	}
+
+	log.Infof("Schedule of Job %s started Job %s", scheduleId, sub.job.JobId)
+	return nil
+}
+
+// deleteJobSchedule deletes the schedule of the recurring job, runs it already started keep running.
+func deleteJobSchedule(ctx context.Context, c client.Client, job *models.Job) error {
+	err := c.ScheduleClient().GetHandle(ctx, job.JobId).Delete(ctx)
+	var notFound *serviceerror.NotFound
+	if err != nil && !errors.As(err, &notFound) {
+		return err
+	}
+	return nil
+}
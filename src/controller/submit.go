@@ -0,0 +1,322 @@
+package controller
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"transform2/config"
+	"transform2/dispatch"
+	"transform2/models"
+	"transform2/rescript"
+	"transform2/service"
+	"transform2/services"
+	"transform2/webhook"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.temporal.io/sdk/client"
+)
+
+// jobSubmission follows one CreateJob request through validation, admission and the workflow start.
+type jobSubmission struct {
+	req      *services.C2S_CreateJobReq
+	job      *models.Job // Job built from the request, nil if the request is invalid
+	existing bool        // The idempotency key was used before, job only carries the ID of the original job
+	err      error       // Reason why the request was rejected or the job could not be started
+}
+
+// rejected returns true if the request was rejected or its job could not be started.
+func (sub *jobSubmission) rejected() bool {
+	return sub.err != nil
+}
+
+// release gives back what the submission took before it was rejected and its job stored,
+// the idempotency key, the place in the queue of its pool and the conversions of its quotas.
+func (sub *jobSubmission) release(ctx context.Context, headers framework.CommonHeaders) {
+	releaseIdempotencyKey(ctx, headers.TenantId, sub.req.IdempotencyKey, sub.job.JobId)
+	refundJobQuotas(ctx, sub.job)
+	if sub.job.QueueReserved {
+		if err := service.ReleasePoolQueue(ctx, sub.job.ResourcePoolId, 1); err != nil {
+			log.Errorf("Failed to release the queue place of job %s: %v", sub.job.JobId, err)
+		}
+		sub.job.QueueReserved = false
+	}
+}
+
+// jobTypeEntry is a job type resolved from the registry together with the pool limiting it.
+type jobTypeEntry struct {
+	jobType   *models.JobType
+	pool      *models.ResourcePool // nil if no pool limits the job type
+	estimator *rescript.Estimator  // Compiled ReScript of the job type, nil if it has none
+	stats     *jobTypeStats        // Conversion times of the job type, loaded when a job is not estimated by the ReScript
+}
+
+// submitJobs validates, stores and starts the jobs of the requests, in the order of the requests.
+// With allOrNothing a single invalid request rejects all of them, otherwise only the invalid ones are skipped.
+func submitJobs(ctx context.Context, c client.Client, headers framework.CommonHeaders, reqs []*services.C2S_CreateJobReq, allOrNothing bool) []*jobSubmission {
+	subs := make([]*jobSubmission, len(reqs))
+	jobTypes := make(map[int32]*jobTypeEntry)
+	for i, req := range reqs {
+		sub := &jobSubmission{req: req}
+		sub.job, sub.err = newJob(ctx, headers, req, jobTypes)
+		subs[i] = sub
+	}
+
+	return startSubmissions(ctx, c, headers, subs, allOrNothing)
+}
+
+// startSubmissions stores and starts the jobs of the submissions that were not rejected yet.
+func startSubmissions(ctx context.Context, c client.Client, headers framework.CommonHeaders, subs []*jobSubmission, allOrNothing bool) []*jobSubmission {
+	valid, invalid := dispatch.SplitBatch(subs, (*jobSubmission).rejected, allOrNothing)
+	for _, sub := range invalid {
+		if sub.err == nil {
+			sub.err = framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Rejected with an invalid Job of the Batch")
+		}
+	}
+
+	// A resubmitted request gets the job of the first submission, no second workflow is started
+	var accepted []*jobSubmission
+	for _, sub := range valid {
+		if key := sub.req.IdempotencyKey; key != "" {
+			existing, err := service.ClaimIdempotencyKey(ctx, headers.TenantId, key, sub.job.JobId, config.IdempotencyKeyExpire)
+			if err != nil {
+				sub.err = err
+				continue
+			}
+			if existing != "" {
+				log.Infof("Idempotency key %s already used by Job %s", key, existing)
+				sub.job = &models.Job{JobId: existing}
+				sub.existing = true
+				continue
+			}
+		}
+		accepted = append(accepted, sub)
+	}
+
+	accepted = admitJobs(ctx, headers, accepted, allOrNothing)
+	accepted = applyQuotas(ctx, headers, accepted, allOrNothing)
+	if len(accepted) == 0 {
+		return subs
+	}
+
+	// Store the Jobs before starting the workflows, so they can always be looked up by ID
+	jobs := make([]*models.Job, len(accepted))
+	for i, sub := range accepted {
+		jobs[i] = sub.job
+	}
+	if err := service.AddJobs(ctx, jobs); err != nil {
+		for _, sub := range accepted {
+			sub.err = err
+			sub.release(ctx, headers)
+		}
+		return subs
+	}
+
+	// Jobs of a shared pool wait for the dispatcher, the others start right away
+	waiting := false
+	for _, sub := range accepted {
+		recordJobEvent(ctx, sub.job)
+		enqueueWebhook(ctx, sub.job)
+		if sub.job.ResourcePoolId != "" {
+			waiting = true
+			continue
+		}
+		if sub.err = startJob(ctx, c, sub.job); sub.err != nil {
+			// The job was never started, let the client retry with the same key
+			releaseIdempotencyKey(ctx, headers.TenantId, sub.req.IdempotencyKey, sub.job.JobId)
+		}
+	}
+	if waiting {
+		notifyDispatcher()
+	}
+
+	return subs
+}
+
+// newJob validates the request and builds the queued job record for it.
+// The job type is resolved from the registry, jobTypes caches the types resolved for the same submission.
+func newJob(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_CreateJobReq, jobTypes map[int32]*jobTypeEntry) (*models.Job, error) {
+	entry, ok := jobTypes[req.JobType]
+	if !ok {
+		jobType, err := service.GetJobTypeById(ctx, strconv.Itoa(int(req.JobType)))
+		if err != nil {
+			return nil, err
+		}
+		pool, err := service.GetResourcePoolOfJobType(ctx, jobType.JobTypeId)
+		if err != nil {
+			return nil, err
+		}
+		entry = &jobTypeEntry{jobType: jobType, pool: pool}
+		// A script stored before scripts were checked estimates nothing
+		if entry.estimator, err = rescript.Compile(jobType.ReScript); err != nil {
+			log.Warnf("Invalid ReScript of Job Type %s: %v", jobType.JobTypeId, err)
+		}
+		jobTypes[req.JobType] = entry
+	}
+	jobType := entry.jobType
+
+	if jobType.TaskQueue == "" || jobType.Workflow == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Job Type "+jobType.JobTypeId+" has no Task Queue or Workflow")
+	}
+
+	intID := GenerateID()          // Invoke the Function to get a Unique ID
+	id := strconv.Itoa(int(intID)) //Convert the integer to string to use as ID
+
+	var targetFormats []string
+	for _, format := range req.TargetFormats {
+		if format = models.NormalizeFormat(format); format == "" {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Target format must not be empty")
+		}
+		targetFormats = append(targetFormats, format)
+	}
+
+	if req.CallbackUrl != "" {
+		if err := webhook.ValidateURL(ctx, req.CallbackUrl); err != nil {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, err.Error())
+		}
+		// Payloads are signed with the secret of the webhook of the organization
+		if headers.TenantId == "" {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required for a Callback URL")
+		}
+		hook, err := service.GetWebhook(ctx, headers.TenantId)
+		if err != nil {
+			return nil, err
+		}
+		if hook == nil || hook.Secret == "" {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Callback URL requires a Webhook Secret of the Organization, set it with SetWebhook")
+		}
+	}
+
+	var notBefore time.Time
+	if req.NotBefore != "" {
+		var err error
+		if notBefore, err = time.Parse(time.RFC3339, req.NotBefore); err != nil {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Not Before must be an RFC 3339 time")
+		}
+	}
+
+	status := models.JobStatusQueued
+	if req.CronSchedule != "" {
+		status = models.JobStatusScheduled
+	}
+
+	priority := dispatch.Priority(config.PriorityTenantTiers, headers.TenantId)
+
+	// Jobs of a shared pool are dispatched when the pool has a free slot, the runs of a recurring job
+	// are submitted on their own and wait for the pool then
+	now := time.Now()
+	var poolId string
+	dispatchedAt := now
+	if entry.pool != nil && status != models.JobStatusScheduled {
+		poolId, dispatchedAt = entry.pool.ResourcePoolID, time.Time{}
+	}
+
+	job := &models.Job{
+		JobId:           id,
+		TenantId:        headers.TenantId,
+		AppId:           headers.AppId,
+		UserId:          headers.ZixelUserId,
+		JobType:         req.JobType,
+		StorageToken:    req.StorageToken,
+		Parameters:      req.Parameters,
+		FixedParameters: jobType.FixedParameters,
+		JeScript:        jobType.JeScript,
+		ScScript:        jobType.ScScript,
+		InputSize:       req.FileSize,
+		SourceFormat:    models.NormalizeFormat(req.SourceFormat),
+		IdempotencyKey:  req.IdempotencyKey,
+		TargetFormats:   targetFormats,
+		CallbackUrl:     req.CallbackUrl,
+		TaskQueue:       dispatch.TaskQueue(jobType.TaskQueue, priority, config.PriorityQueueSuffix),
+		Priority:        priority,
+		ResourcePoolId:  poolId,
+		DispatchedAt:    dispatchedAt,
+		NotBefore:       notBefore,
+		CronSchedule:    req.CronSchedule,
+		Workflow:        jobType.Workflow,
+		RetryPolicy:     jobType.RetryPolicy,
+		Timeouts:        jobType.Timeouts,
+		Status:          status,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	job.Resources = estimateResources(ctx, entry, job)
+	job.Outbox = []models.OutboxEvent{newOutboxEvent(job, models.OutboxEventCreated)}
+
+	return job, nil
+}
+
+// startJob starts the workflow of a stored job without waiting for the result, the job ID is used as workflow ID.
+// A delayed job is started right away, Temporal dispatches its first workflow task at NotBefore.
+// Temporal times the workflow out after the job timeout, the delay does not count against it.
+// Recurring jobs get a Temporal schedule instead. A job whose workflow cannot be started is finished as Failed.
+func startJob(ctx context.Context, c client.Client, job *models.Job) error {
+	if job.Status == models.JobStatusScheduled {
+		return startJobSchedule(ctx, c, job)
+	}
+
+	workflowOptions := client.StartWorkflowOptions{
+		ID:        job.JobId,
+		TaskQueue: job.TaskQueue,
+	}
+	if delay := time.Until(job.NotBefore); delay > 0 {
+		workflowOptions.StartDelay = delay
+	}
+	if timeout := jobTimeout(job); timeout > 0 {
+		workflowOptions.WorkflowExecutionTimeout = workflowOptions.StartDelay + timeout
+	}
+
+	log.Debugf("Starting Workflow %s for Job %s", job.Workflow, job.JobId)
+	run, err := c.ExecuteWorkflow(ctx, workflowOptions, job.Workflow, workflowArgs(job)...)
+	if err != nil {
+		log.Errorf("Failed to start workflow: %v", err)
+		return failJobStart(ctx, job, models.ErrorInternal, err)
+	}
+
+	job.RunId = run.GetRunID()
+	if err := service.UpdateJob(ctx, job.JobId, bson.M{"RunId": job.RunId}); err != nil {
+		log.Errorf("Failed to store the run ID of job %s: %v", job.JobId, err)
+	}
+
+	return nil
+}
+
+// workflowArgs returns the arguments the workflow of the job is started with.
+func workflowArgs(job *models.Job) []interface{} {
+	options := models.WorkflowOptions{
+		RetryPolicy: job.RetryPolicy,
+		Files:       job.RetryFiles,
+		JeScript:    job.JeScript,
+		ScScript:    job.ScScript,
+		Timeouts:    job.Timeouts,
+		InputSize:   job.InputSize,
+	}
+	return []interface{}{job.StorageToken, job.Parameters, job.FixedParameters, options}
+}
+
+// jobTimeout returns the time the workflow of the job may take, 0 if it is not limited.
+func jobTimeout(job *models.Job) time.Duration {
+	return job.Timeouts.JobTimeout(int64(config.JobTimeout), job.InputSize)
+}
+
+// failJobStart finishes the job that could not be started as Failed with the category and returns the job error.
+// The conversions the job took from its quotas are refunded.
+func failJobStart(ctx context.Context, job *models.Job, category string, err error) error {
+	job.Status, job.Message, job.ErrorCategory = models.JobStatusFailed, err.Error(), category
+	service.FinishJob(ctx, job.JobId, job.Status, job.ErrorCategory, job.Message, newOutboxEvent(job, models.OutboxEventFailed))
+	recordJobEvent(ctx, job)
+	recordJobUsage(ctx, job)
+	releaseJobQueue(ctx, job)
+	releaseJobSlots(ctx, job)
+	refundJobQuotas(ctx, job)
+	enqueueWebhook(ctx, job)
+	return config.NewJobError(category, err.Error())
+}
+
+// errorCode returns the code of a service error, other errors are reported as server errors.
+func errorCode(err error) int {
+	if serviceErr, ok := err.(framework.ServiceError); ok {
+		return serviceErr.Code
+	}
+	return framework.ERR_SYS_SERVER
+}
@@ -0,0 +1,603 @@
+package controller
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+	"transform2/config"
+	"transform2/models"
+	"transform2/service"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	enums "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/temporal"
+)
+
+// CreateJob stores a new job and starts its workflow without waiting for the result.
+func CreateJob(ctx context.Context, c client.Client, headers framework.CommonHeaders, req *services.C2S_CreateJobReq) (*services.S2C_CreateJobRpn, error) {
+
+	log.Infof("Controller for Create Job")
+
+	sub := submitJobs(ctx, c, headers, []*services.C2S_CreateJobReq{req}, true)[0]
+	if sub.err != nil {
+		return nil, sub.err
+	}
+
+	message := "Job Created"
+	if sub.existing {
+		message = "Job Already Created"
+	}
+
+	return &services.S2C_CreateJobRpn{
+		StatusCode: 200,
+		Message:    message,
+		JobID:      sub.job.JobId,
+	}, nil
+}
+
+// CreateJobs creates a job for every entry of the batch and reports the result of each entry.
+func CreateJobs(ctx context.Context, c client.Client, headers framework.CommonHeaders, req *services.C2S_CreateJobsReq) (*services.S2C_CreateJobsRpn, error) {
+
+	log.Infof("Controller for Create Jobs")
+
+	if len(req.Jobs) == 0 {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "No Jobs Given")
+	}
+	if int64(len(req.Jobs)) > config.CreateJobsLimit {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, fmt.Sprintf("At most %d Jobs per Batch", config.CreateJobsLimit))
+	}
+
+	subs := submitJobs(ctx, c, headers, req.Jobs, req.AllOrNothing)
+
+	created := 0
+	results := make([]*services.CreateJobResult, len(subs))
+	for i, sub := range subs {
+		result := &services.CreateJobResult{Index: int32(i), StatusCode: 200, Message: "Job Created"}
+		switch {
+		case sub.err != nil:
+			result.StatusCode = int32(errorCode(sub.err))
+			result.Message = sub.err.Error()
+		case sub.existing:
+			result.JobId = sub.job.JobId
+			result.Message = "Job Already Created"
+		default:
+			result.JobId = sub.job.JobId
+			created++
+		}
+		results[i] = result
+	}
+
+	return &services.S2C_CreateJobsRpn{
+		StatusCode: 200,
+		Message:    fmt.Sprintf("%d of %d Jobs Created", created, len(subs)),
+		Results:    results,
+	}, nil
+}
+
+// releaseIdempotencyKey frees the key of a job that could not be created, an empty key is ignored.
+func releaseIdempotencyKey(ctx context.Context, tenantId string, key string, jobId string) {
+	if key == "" {
+		return
+	}
+	if err := service.ReleaseIdempotencyKey(ctx, tenantId, key, jobId); err != nil {
+		log.Errorf("Failed to release idempotency key %s of job %s: %v", key, jobId, err)
+	}
+}
+
+// getTenantJob returns the job if it belongs to the organization of the caller. A job of another
+// organization is reported as not found, so callers cannot tell it from a job that does not exist.
+func getTenantJob(ctx context.Context, headers framework.CommonHeaders, jobId string) (*models.Job, error) {
+	job, err := service.GetJob(ctx, jobId)
+	if err != nil {
+		return nil, err
+	}
+	if job.TenantId != headers.TenantId {
+		log.Warnf("Job %s of organization %s requested by organization %s", jobId, job.TenantId, headers.TenantId)
+		return nil, config.NewJobError(models.ErrorNotFound, "No Such Job in the Database")
+	}
+	return job, nil
+}
+
+// GetJobInfo returns the stored job, refreshed with the live progress of its workflow.
+func GetJobInfo(ctx context.Context, c client.Client, headers framework.CommonHeaders, req *services.C2S_GetJobInfoReq) (*services.S2C_GetJobInfoRpn, error) {
+
+	log.Infof("Controller for Get Job Info")
+
+	job, err := getTenantJob(ctx, headers, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if !IsJobFinished(job.Status) {
+		if err := SyncJob(ctx, c, job); err != nil {
+			log.Errorf("Failed to sync job %s with its workflow: %v", job.JobId, err)
+		}
+	}
+
+	return &services.S2C_GetJobInfoRpn{
+		StatusCode: 200,
+		Message:    "Job Found",
+		Data:       NewJobInfo(job),
+	}, nil
+}
+
+// ListJobs returns the jobs of the organization of the caller, newest first.
+func ListJobs(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_ListJobsReq) (*services.S2C_ListJobsRpn, error) {
+
+	log.Infof("Controller for List Jobs")
+
+	if headers.TenantId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required to list Jobs")
+	}
+
+	filter := bson.M{"TenantId": headers.TenantId}
+	if req.Status != "" {
+		filter["Status"] = req.Status
+	}
+	if req.ScheduleId != "" {
+		filter["ScheduleId"] = req.ScheduleId
+	}
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+
+	jobs, err := service.QueryJobs(ctx, filter, int64(req.Skip), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	rpn := &services.S2C_ListJobsRpn{
+		StatusCode: 200,
+		Message:    fmt.Sprintf("%d Jobs Found", len(jobs)),
+	}
+	for i := range jobs {
+		rpn.Jobs = append(rpn.Jobs, NewJobInfo(&jobs[i]))
+	}
+
+	return rpn, nil
+}
+
+// CancelJob cancels the workflow of the job and records the Cancelled status with the reason.
+// A job cancelled before any of its files started gives its conversions back to its quotas.
+func CancelJob(ctx context.Context, c client.Client, headers framework.CommonHeaders, req *services.C2S_CancelJobReq) (*services.S2C_CancelJobRpn, error) {
+
+	log.Infof("Controller for Cancel Job")
+
+	job, err := getTenantJob(ctx, headers, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if IsJobFinished(job.Status) {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Job is already "+job.Status)
+	}
+
+	if job.Status == models.JobStatusScheduled {
+		// Runs of a recurring job are cancelled on their own
+		if err := deleteJobSchedule(ctx, c, job); err != nil {
+			log.Errorf("Failed to delete schedule of job %s: %v", job.JobId, err)
+			return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, err.Error())
+		}
+	} else {
+		// A job still waiting for its pool is claimed, so the dispatcher never starts its workflow
+		waiting, err := service.ClaimJobDispatch(ctx, job.JobId, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		// Running activities see the cancellation on their next heartbeat, a paused job
+		// waiting for its pool to resume has a workflow as well
+		if !waiting || job.RunId != "" {
+			if err := c.CancelWorkflow(ctx, job.JobId, job.RunId); err != nil {
+				log.Errorf("Failed to cancel workflow of job %s: %v", job.JobId, err)
+				return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, err.Error())
+			}
+		}
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "Cancelled by user"
+	}
+
+	job.Status, job.Message, job.ErrorCategory = models.JobStatusCancelled, reason, models.ErrorCancelled
+	if err := service.FinishJob(ctx, job.JobId, job.Status, job.ErrorCategory, job.Message, newOutboxEvent(job, models.OutboxEventFailed)); err != nil {
+		return nil, err
+	}
+	recordJobEvent(ctx, job)
+	recordJobUsage(ctx, job)
+	releaseJobQueue(ctx, job)
+	releaseJobSlots(ctx, job)
+	if job.StartedAt.IsZero() {
+		refundJobQuotas(ctx, job)
+	}
+	enqueueWebhook(ctx, job)
+
+	return &services.S2C_CancelJobRpn{
+		StatusCode: 200,
+		Message:    "Job Cancelled",
+	}, nil
+}
+
+// PauseJob signals the workflow of the job to dispatch no further files and records the Paused status.
+// Files already dispatched finish and keep their results, a paused job gives its pool slot to waiting jobs.
+func PauseJob(ctx context.Context, c client.Client, headers framework.CommonHeaders, req *services.C2S_PauseJobReq) (*services.S2C_PauseJobRpn, error) {
+
+	log.Infof("Controller for Pause Job")
+
+	job, err := getTenantJob(ctx, headers, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if reason := job.CheckPause(time.Now()); reason != "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, reason)
+	}
+
+	if err := c.SignalWorkflow(ctx, job.JobId, job.RunId, models.JobPauseSignal, nil); err != nil {
+		log.Errorf("Failed to pause workflow of job %s: %v", job.JobId, err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, err.Error())
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "Paused by user"
+	}
+
+	job.Status, job.Message = models.JobStatusPaused, reason
+	if err := service.UpdateJob(ctx, job.JobId, bson.M{"Status": job.Status, "Message": job.Message}, newOutboxEvent(job, models.OutboxEventProgress)); err != nil {
+		return nil, err
+	}
+	recordJobEvent(ctx, job)
+	enqueueWebhook(ctx, job)
+	releaseJobSlots(ctx, job)
+	notifyDispatcher()
+
+	return &services.S2C_PauseJobRpn{
+		StatusCode: 200,
+		Message:    "Job Paused",
+	}, nil
+}
+
+// ResumeJob signals the workflow of the paused job to dispatch its remaining files, the job takes
+// the status its workflow reports. A job of a shared pool is queued again instead, the dispatcher
+// resumes it when the pool has free slots for it.
+func ResumeJob(ctx context.Context, c client.Client, headers framework.CommonHeaders, req *services.C2S_ResumeJobReq) (*services.S2C_ResumeJobRpn, error) {
+
+	log.Infof("Controller for Resume Job")
+
+	job, err := getTenantJob(ctx, headers, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	if reason := job.CheckResume(); reason != "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, reason)
+	}
+
+	if job.ResourcePoolId != "" {
+		job.Status, job.Message, job.DispatchedAt = models.JobStatusQueued, "Waiting for Resource Pool "+job.ResourcePoolId, time.Time{}
+		if err := service.UpdateJob(ctx, job.JobId, bson.M{"Status": job.Status, "Message": job.Message, "DispatchedAt": job.DispatchedAt}, newOutboxEvent(job, models.OutboxEventProgress)); err != nil {
+			return nil, err
+		}
+		recordJobEvent(ctx, job)
+		enqueueWebhook(ctx, job)
+		notifyDispatcher()
+
+		return &services.S2C_ResumeJobRpn{
+			StatusCode: 200,
+			Message:    "Job Queued",
+		}, nil
+	}
+
+	if err := resumeWorkflow(ctx, c, job); err != nil {
+		return nil, err
+	}
+
+	return &services.S2C_ResumeJobRpn{
+		StatusCode: 200,
+		Message:    "Job Resumed",
+	}, nil
+}
+
+// resumeWorkflow signals the workflow of the paused job to dispatch its remaining files
+// and stores the status the workflow reports then. It only fails if the workflow was not
+// signalled, a status that cannot be stored yet is stored by the next sync.
+func resumeWorkflow(ctx context.Context, c client.Client, job *models.Job) error {
+	if err := c.SignalWorkflow(ctx, job.JobId, job.RunId, models.JobResumeSignal, nil); err != nil {
+		log.Errorf("Failed to resume workflow of job %s: %v", job.JobId, err)
+		return framework.NewServiceError(framework.ERR_SYS_SERVER, err.Error())
+	}
+	if err := SyncJob(ctx, c, job); err != nil {
+		log.Errorf("Failed to sync resumed job %s: %v", job.JobId, err)
+	}
+	return nil
+}
+
+// RetryJob creates a new job that reruns the files the finished job did not convert,
+// the new job keeps the ID of the original job in RetryOf.
+func RetryJob(ctx context.Context, c client.Client, headers framework.CommonHeaders, req *services.C2S_RetryJobReq) (*services.S2C_RetryJobRpn, error) {
+
+	log.Infof("Controller for Retry Job")
+
+	original, err := getTenantJob(ctx, headers, req.JobId)
+	if err != nil {
+		return nil, err
+	}
+
+	switch original.Status {
+	case models.JobStatusFailed, models.JobStatusPartiallyFailed, models.JobStatusCancelled:
+	default:
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Job is "+original.Status+", only failed or cancelled Jobs can be retried")
+	}
+	if original.CronSchedule != "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Scheduled Jobs cannot be retried, retry their runs instead")
+	}
+
+	// The new job belongs to the submitter of the original job
+	headers = framework.CommonHeaders{
+		TenantId:    original.TenantId,
+		AppId:       original.AppId,
+		ZixelUserId: original.UserId,
+	}
+	sub := &jobSubmission{req: &services.C2S_CreateJobReq{
+		JobType:       original.JobType,
+		StorageToken:  original.StorageToken,
+		Parameters:    original.Parameters,
+		FileSize:      original.InputSize,
+		SourceFormat:  original.SourceFormat,
+		TargetFormats: original.TargetFormats,
+		CallbackUrl:   original.CallbackUrl,
+	}}
+	if sub.job, sub.err = newJob(ctx, headers, sub.req, make(map[int32]*jobTypeEntry)); sub.err == nil {
+		sub.job.RetryOf = original.JobId
+		sub.job.RetryFiles = models.RetryFiles(original.Files)
+	}
+
+	if sub = startSubmissions(ctx, c, headers, []*jobSubmission{sub}, true)[0]; sub.err != nil {
+		return nil, sub.err
+	}
+
+	log.Infof("Job %s retries Job %s", sub.job.JobId, original.JobId)
+	return &services.S2C_RetryJobRpn{
+		StatusCode: 200,
+		Message:    "Job Retried",
+		JobID:      sub.job.JobId,
+	}, nil
+}
+
+// SyncJob refreshes the job with the state of its workflow execution and stores the changes.
+func SyncJob(ctx context.Context, c client.Client, job *models.Job) error {
+	if job.Status == models.JobStatusScheduled {
+		return syncJobSchedule(ctx, c, job)
+	}
+
+	// The workflow of a job waiting for its pool or its start time is not running yet
+	if !job.WorkflowStarted(time.Now()) {
+		return nil
+	}
+
+	describe, err := c.DescribeWorkflowExecution(ctx, job.JobId, job.RunId)
+	if err != nil {
+		return err
+	}
+
+	before := *job
+
+	// Running workflows report their progress through the query handler,
+	// closed workflows are queried for the final file results if possible
+	var progress models.JobProgress
+	if value, err := c.QueryWorkflow(ctx, job.JobId, job.RunId, models.JobProgressQuery); err == nil {
+		if err := value.Get(&progress); err != nil {
+			log.Errorf("Failed to decode progress of job %s: %v", job.JobId, err)
+		}
+	} else {
+		log.Debugf("Failed to query progress of job %s: %v", job.JobId, err)
+	}
+
+	info := describe.WorkflowExecutionInfo
+	switch info.GetStatus() {
+	case enums.WORKFLOW_EXECUTION_STATUS_RUNNING, enums.WORKFLOW_EXECUTION_STATUS_CONTINUED_AS_NEW:
+		// The final status is only taken once the workflow has closed
+		job.Status = models.JobStatusRunning
+		if progress.Status != "" && !IsJobFinished(progress.Status) {
+			job.Status = progress.Status
+		}
+		// The job stays queued until a worker picks up one of its files
+		if job.Status == models.JobStatusRunning && !isJobStarted(describe, &progress) {
+			job.Status = models.JobStatusQueued
+		}
+		applyHeartbeatProgress(describe, &progress)
+		job.Progress = progress.Progress
+		job.Message = progress.Message
+		job.ErrorCategory = progress.ErrorCategory
+	case enums.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		// The workflow returns the result of every file
+		var files []models.FileProgress
+		if err := c.GetWorkflow(ctx, job.JobId, job.RunId).Get(ctx, &files); err != nil {
+			log.Errorf("Failed to get the result of job %s: %v", job.JobId, err)
+		} else {
+			progress.Files = files
+		}
+		job.Status = models.JobResultStatus(progress.Files)
+		job.Progress = 100
+		job.ErrorCategory = ""
+		for _, file := range progress.Files {
+			if file.Status == models.FileStatusFailed {
+				job.ErrorCategory = file.ErrorCategory
+				break
+			}
+		}
+	case enums.WORKFLOW_EXECUTION_STATUS_CANCELED:
+		job.Status = models.JobStatusCancelled
+		job.ErrorCategory = models.ErrorCancelled
+	case enums.WORKFLOW_EXECUTION_STATUS_TIMED_OUT:
+		job.Status = models.JobStatusFailed
+		job.Message = info.GetStatus().String()
+		job.ErrorCategory = models.ErrorTimeout
+	case enums.WORKFLOW_EXECUTION_STATUS_FAILED:
+		job.Status = models.JobStatusFailed
+		job.Message, job.ErrorCategory = workflowFailure(ctx, c, job)
+	default:
+		job.Status = models.JobStatusFailed
+		job.Message = info.GetStatus().String()
+		job.ErrorCategory = models.ErrorInternal
+	}
+
+	if progress.Files != nil {
+		job.Files = progress.Files
+	}
+
+	now := time.Now()
+	if job.Status != models.JobStatusQueued && job.StartedAt.IsZero() {
+		job.StartedAt = now
+	}
+	if IsJobFinished(job.Status) && job.FinishedAt.IsZero() {
+		job.FinishedAt = now
+		if info.GetCloseTime() != nil {
+			job.FinishedAt = *info.GetCloseTime()
+		}
+	}
+
+	// Only store the job if something changed, so UpdatedAt keeps its meaning
+	if reflect.DeepEqual(before, *job) {
+		return nil
+	}
+
+	job.UpdatedAt = now
+	if err := service.UpdateJob(ctx, job.JobId, bson.M{
+		"Status":        job.Status,
+		"Progress":      job.Progress,
+		"Message":       job.Message,
+		"ErrorCategory": job.ErrorCategory,
+		"Files":         job.Files,
+		"StartedAt":     job.StartedAt,
+		"FinishedAt":    job.FinishedAt,
+		"UpdatedAt":     job.UpdatedAt,
+	}, newOutboxEvent(job, models.OutboxEventType(job.Status))); err != nil {
+		return err
+	}
+
+	recordJobEvent(ctx, job)
+	if job.Status != before.Status {
+		enqueueWebhook(ctx, job)
+	}
+	if IsJobFinished(job.Status) && !IsJobFinished(before.Status) {
+		recordJobUsage(ctx, job)
+		releaseJobQueue(ctx, job)
+		releaseJobSlots(ctx, job)
+	}
+	return nil
+}
+
+// workflowFailure returns the message and error category of a failed workflow,
+// activities report the category as type of their application errors.
+func workflowFailure(ctx context.Context, c client.Client, job *models.Job) (string, string) {
+	err := c.GetWorkflow(ctx, job.JobId, job.RunId).Get(ctx, nil)
+	if err == nil {
+		return enums.WORKFLOW_EXECUTION_STATUS_FAILED.String(), models.ErrorInternal
+	}
+
+	// A timeout may carry the failure of an earlier attempt, the job still timed out
+	var timeoutErr *temporal.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return timeoutErr.Error(), models.ErrorTimeout
+	}
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) {
+		if _, ok := config.ErrorCategoryCodes[appErr.Type()]; ok {
+			return appErr.Error(), appErr.Type()
+		}
+	}
+
+	return err.Error(), models.ErrorInternal
+}
+
+// applyHeartbeatProgress applies the FileProgressUpdate of the last heartbeat of every pending activity
+// to the files reported by the workflow, so the progress of a file is known while it is converted.
+func applyHeartbeatProgress(describe *workflowservice.DescribeWorkflowExecutionResponse, progress *models.JobProgress) {
+	for _, activity := range describe.GetPendingActivities() {
+		var update models.FileProgressUpdate
+		if err := converter.GetDefaultDataConverter().FromPayloads(activity.GetHeartbeatDetails(), &update); err != nil || update.File == "" {
+			continue
+		}
+		progress.ApplyFileProgress(update)
+	}
+}
+
+// isJobStarted returns true once a worker started or finished one of the files of the job.
+func isJobStarted(describe *workflowservice.DescribeWorkflowExecutionResponse, progress *models.JobProgress) bool {
+	for _, activity := range describe.GetPendingActivities() {
+		if activity.GetState() == enums.PENDING_ACTIVITY_STATE_STARTED {
+			return true
+		}
+	}
+
+	for _, file := range progress.Files {
+		if file.Status != models.FileStatusPending {
+			return true
+		}
+	}
+	return false
+}
+
+// IsJobFinished returns true if the job status will not change anymore.
+func IsJobFinished(status string) bool {
+	switch status {
+	case models.JobStatusSucceeded, models.JobStatusPartiallyFailed, models.JobStatusFailed, models.JobStatusCancelled:
+		return true
+	}
+	return false
+}
+
+// NewJobInfo converts the job record to the JobInfo message.
+func NewJobInfo(job *models.Job) *services.JobInfo {
+	info := &services.JobInfo{
+		JobId:         job.JobId,
+		JobType:       strconv.Itoa(int(job.JobType)),
+		Status:        job.Status,
+		Progress:      job.Progress,
+		Message:       job.Message,
+		ErrorCategory: job.ErrorCategory,
+		RetryOf:       job.RetryOf,
+		Priority:      job.Priority,
+		CreatedAt:     job.CreatedAt.Format(time.RFC3339),
+		UpdatedAt:     job.UpdatedAt.Format(time.RFC3339),
+		Files:         newFileProgresses(job.Files),
+		CronSchedule:  job.CronSchedule,
+		ScheduleId:    job.ScheduleId,
+	}
+	if !job.NotBefore.IsZero() {
+		info.NotBefore = job.NotBefore.Format(time.RFC3339)
+	}
+	if job.Status == models.JobStatusScheduled && !job.NextRunAt.IsZero() {
+		info.NextRunAt = job.NextRunAt.Format(time.RFC3339)
+	}
+	return info
+}
+
+// newFileProgresses converts the file progress of a job to FileProgress messages.
+func newFileProgresses(files []models.FileProgress) []*services.FileProgress {
+	var msgs []*services.FileProgress
+	for _, file := range files {
+		msgs = append(msgs, &services.FileProgress{
+			File:          file.File,
+			Status:        file.Status,
+			Progress:      file.Progress,
+			Message:       file.Message,
+			ErrorCategory: file.ErrorCategory,
+			Outputs:       file.Outputs,
+			DurationMs:    file.DurationMs,
+		})
+	}
+	return msgs
+}
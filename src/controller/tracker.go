@@ -0,0 +1,46 @@
+package controller
+
+import (
+	"context"
+	"time"
+	"transform2/models"
+	"transform2/service"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.temporal.io/sdk/client"
+)
+
+// TrackJobs periodically syncs all unfinished jobs with their workflows until the context is done,
+// so the stored status stays accurate even if nobody asks for the job.
+func TrackJobs(ctx context.Context, c client.Client, interval time.Duration) {
+	log.Infof("Job tracker started, interval %v", interval)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Job tracker stopped")
+			return
+		case <-ticker.C:
+			syncUnfinishedJobs(ctx, c)
+		}
+	}
+}
+
+// syncUnfinishedJobs syncs every scheduled, queued, running or paused job once.
+func syncUnfinishedJobs(ctx context.Context, c client.Client) {
+	filter := bson.M{"Status": bson.M{"$in": []string{models.JobStatusScheduled, models.JobStatusQueued, models.JobStatusRunning, models.JobStatusPaused}}}
+	jobs, err := service.GetJobs(ctx, filter, bson.D{{Key: "CreatedAt", Value: 1}}, 0)
+	if err != nil {
+		log.Errorf("Failed to load unfinished jobs: %v", err)
+		return
+	}
+
+	for i := range jobs {
+		if err := SyncJob(ctx, c, &jobs[i]); err != nil {
+			log.Errorf("Failed to sync job %s with its workflow: %v", jobs[i].JobId, err)
+		}
+	}
+}
@@ -0,0 +1,204 @@
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+	"transform2/config"
+	"transform2/models"
+	"transform2/service"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+)
+
+// recordJobUsage meters the finished job, failures are only logged so they never fail the job.
+// Recurring jobs are metered by their runs.
+func recordJobUsage(ctx context.Context, job *models.Job) {
+	if job.CronSchedule != "" {
+		return
+	}
+	if err := service.AddUsageRecord(ctx, models.NewUsageRecord(job)); err != nil {
+		log.Errorf("Failed to record the usage of job %s: %v", job.JobId, err)
+	}
+}
+
+// GetUsage returns the usage per organization and billing period, see usageReport for the organizations the caller reads.
+func GetUsage(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_GetUsageReq) (*services.S2C_GetUsageRpn, error) {
+
+	log.Infof("Controller for Get Usage")
+
+	usages, _, err := usageReport(ctx, headers, req)
+	if err != nil {
+		return nil, err
+	}
+
+	rpn := &services.S2C_GetUsageRpn{
+		StatusCode: 200,
+		Message:    fmt.Sprintf("%d Usages Found", len(usages)),
+	}
+	for _, usage := range usages {
+		rpn.Usages = append(rpn.Usages, &services.Usage{
+			TenantId:          usage.TenantId,
+			Period:            usage.Period,
+			Jobs:              usage.Jobs,
+			FailedJobs:        usage.FailedJobs,
+			QueueSeconds:      usage.QueueSeconds,
+			ComputeSeconds:    usage.ComputeSeconds,
+			InputBytes:        usage.InputBytes,
+			PoolUptimeSeconds: usage.PoolUptimeSeconds,
+		})
+	}
+
+	return rpn, nil
+}
+
+// ExportUsage returns the usage per organization and billing period as CSV file, see usageReport for the organizations the caller reads.
+func ExportUsage(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_GetUsageReq) (*services.S2C_ExportUsageRpn, error) {
+
+	log.Infof("Controller for Export Usage")
+
+	usages, tenantId, err := usageReport(ctx, headers, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write([]string{"TenantId", "Period", "Jobs", "FailedJobs", "QueueSeconds", "ComputeSeconds", "InputBytes", "PoolUptimeSeconds"})
+	for _, usage := range usages {
+		w.Write([]string{
+			usage.TenantId,
+			usage.Period,
+			strconv.FormatInt(usage.Jobs, 10),
+			strconv.FormatInt(usage.FailedJobs, 10),
+			strconv.FormatInt(usage.QueueSeconds, 10),
+			strconv.FormatInt(usage.ComputeSeconds, 10),
+			strconv.FormatInt(usage.InputBytes, 10),
+			strconv.FormatInt(usage.PoolUptimeSeconds, 10),
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, err.Error())
+	}
+
+	name := "usage"
+	if tenantId != "" {
+		name += "-" + tenantId
+	}
+	return &services.S2C_ExportUsageRpn{
+		StatusCode: 200,
+		Message:    fmt.Sprintf("%d Usages Exported", len(usages)),
+		FileName:   name + ".csv",
+		Csv:        buf.Bytes(),
+	}, nil
+}
+
+// usageReport aggregates the job usage and the uptime of the exclusive pools per organization and
+// billing period. Jobs are billed in the period they finished in, uptime intervals are split at the
+// period boundaries and open intervals count until now. The report is scoped to the organization of the
+// caller, only admin organizations read other organizations or all of them, it returns the organization read.
+func usageReport(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_GetUsageReq) ([]*models.Usage, string, error) {
+	if headers.TenantId == "" {
+		return nil, "", framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required to read the Usage")
+	}
+	tenantId, ok := models.UsageTenant(config.UsageAdminTenants, headers.TenantId, req.TenantId)
+	if !ok {
+		return nil, "", framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization "+headers.TenantId+" may not read the Usage of organization "+req.TenantId)
+	}
+
+	period := req.Period
+	if period == "" {
+		period = models.UsagePeriodMonth
+	}
+	if period != models.UsagePeriodMonth && period != models.UsagePeriodDay {
+		return nil, "", framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Unknown billing period "+period+", expected month or day")
+	}
+
+	if req.From == "" {
+		return nil, "", framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Start of the time range is required")
+	}
+	from, err := time.Parse(time.RFC3339, req.From)
+	if err != nil {
+		return nil, "", framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Invalid time "+req.From+", expected RFC 3339")
+	}
+	now := time.Now()
+	to := now
+	if req.To != "" {
+		if to, err = time.Parse(time.RFC3339, req.To); err != nil {
+			return nil, "", framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Invalid time "+req.To+", expected RFC 3339")
+		}
+	}
+	if !from.Before(to) {
+		return nil, "", framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Start of the time range must be before its end")
+	}
+
+	jobUsages, err := service.AggregateUsage(ctx, tenantId, from, to, period)
+	if err != nil {
+		return nil, "", err
+	}
+	uptimes, err := service.GetPoolUptimes(ctx, tenantId, from, to)
+	if err != nil {
+		return nil, "", err
+	}
+
+	usages := make(map[[2]string]*models.Usage)
+	usageOf := func(tenantId string, name string) *models.Usage {
+		key := [2]string{tenantId, name}
+		if usages[key] == nil {
+			usages[key] = &models.Usage{TenantId: tenantId, Period: name}
+		}
+		return usages[key]
+	}
+
+	for i := range jobUsages {
+		usage := jobUsages[i]
+		*usageOf(usage.TenantId, usage.Period) = usage
+	}
+
+	for _, uptime := range uptimes {
+		start, stop := uptime.StartedAt, uptime.StoppedAt
+		if stop.IsZero() {
+			stop = now
+		}
+		if start.Before(from) {
+			start = from
+		}
+		if stop.After(to) {
+			stop = to
+		}
+
+		for periodStart := models.UsagePeriodStart(start, period); periodStart.Before(stop); {
+			next := models.NextUsagePeriod(periodStart, period)
+			begin, end := start, stop
+			if begin.Before(periodStart) {
+				begin = periodStart
+			}
+			if end.After(next) {
+				end = next
+			}
+			if end.After(begin) {
+				usageOf(uptime.TenantId, models.UsagePeriodName(periodStart, period)).PoolUptimeSeconds += int64(end.Sub(begin) / time.Second)
+			}
+			periodStart = next
+		}
+	}
+
+	result := make([]*models.Usage, 0, len(usages))
+	for _, usage := range usages {
+		result = append(result, usage)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].TenantId != result[j].TenantId {
+			return result[i].TenantId < result[j].TenantId
+		}
+		return result[i].Period < result[j].Period
+	})
+
+	return result, tenantId, nil
+}
@@ -0,0 +1,175 @@
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+	"transform2/config"
+	"transform2/models"
+	"transform2/service"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// watchBatchSize limits the number of events loaded from the database at once.
+const watchBatchSize = 100
+
+// jobEventNotifier wakes up the watch streams of this instance when a job event is stored.
+// Events stored by other instances are picked up by polling.
+type jobEventNotifier struct {
+	sync.Mutex
+	subscribers map[chan struct{}]struct{}
+}
+
+var jobEventNotify = &jobEventNotifier{subscribers: make(map[chan struct{}]struct{})}
+
+func (n *jobEventNotifier) subscribe() chan struct{} {
+	ch := make(chan struct{}, 1)
+	n.Lock()
+	n.subscribers[ch] = struct{}{}
+	n.Unlock()
+	return ch
+}
+
+func (n *jobEventNotifier) unsubscribe(ch chan struct{}) {
+	n.Lock()
+	delete(n.subscribers, ch)
+	n.Unlock()
+}
+
+func (n *jobEventNotifier) notify() {
+	n.Lock()
+	defer n.Unlock()
+	for ch := range n.subscribers {
+		// a pending wake up already covers this event
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// recordJobEvent stores the current state of the job as a new event and wakes up the watchers.
+func recordJobEvent(ctx context.Context, job *models.Job) {
+	event := &models.JobEvent{
+		JobId:         job.JobId,
+		TenantId:      job.TenantId,
+		Status:        job.Status,
+		Progress:      job.Progress,
+		Message:       job.Message,
+		ErrorCategory: job.ErrorCategory,
+		Files:         job.Files,
+		CreatedAt:     time.Now(),
+	}
+
+	if err := service.AddJobEvent(ctx, event); err != nil {
+		log.Errorf("Failed to record event of job %s: %v", job.JobId, err)
+		return
+	}
+
+	jobEventNotify.notify()
+}
+
+// WatchJob sends the events of the job after fromSeq and then every new event until the job finishes.
+func WatchJob(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_WatchJobReq, send func(*services.JobEvent) error) error {
+
+	log.Infof("Controller for Watch Job")
+
+	job, err := getTenantJob(ctx, headers, req.JobId)
+	if err != nil {
+		return err
+	}
+
+	// The finished event is always the last one of a job, a watcher that already received it gets nothing
+	finished := IsJobFinished(job.Status)
+	return watchJobEvents(ctx, bson.M{"JobId": job.JobId}, req.FromSeq, send, func(event *models.JobEvent, sent bool) bool {
+		if event != nil {
+			return IsJobFinished(event.Status)
+		}
+		return finished && !sent
+	})
+}
+
+// WatchJobs sends the events of all jobs of the organization after fromSeq until the client disconnects,
+// fromSeq 0 starts with the next event.
+func WatchJobs(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_WatchJobsReq, send func(*services.JobEvent) error) error {
+
+	log.Infof("Controller for Watch Jobs")
+
+	if headers.TenantId == "" {
+		return framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization Id is required")
+	}
+
+	seq := req.FromSeq
+	if seq == 0 {
+		var err error
+		if seq, err = service.GetLastJobEventSeq(ctx); err != nil {
+			return err
+		}
+	}
+
+	return watchJobEvents(ctx, bson.M{"TenantId": headers.TenantId}, seq, send, func(*models.JobEvent, bool) bool {
+		return false
+	})
+}
+
+// watchJobEvents sends the events matching the filter in sequence order until done returns true or the context ends.
+// done is called with every sent event and with nil after each caught up batch, sent tells if any event was sent.
+func watchJobEvents(ctx context.Context, filter bson.M, seq int64, send func(*services.JobEvent) error, done func(event *models.JobEvent, sent bool) bool) error {
+	notify := jobEventNotify.subscribe()
+	defer jobEventNotify.unsubscribe(notify)
+
+	ticker := time.NewTicker(config.JobSyncInterval)
+	defer ticker.Stop()
+
+	sent := false
+	for {
+		events, err := service.GetJobEvents(ctx, filter, seq, watchBatchSize)
+		if err != nil {
+			return err
+		}
+
+		for i := range events {
+			if err := send(newJobEvent(&events[i])); err != nil {
+				return err
+			}
+			seq = events[i].Seq
+			sent = true
+			if done(&events[i], sent) {
+				return nil
+			}
+		}
+
+		// more events are waiting, load them right away
+		if len(events) == watchBatchSize {
+			continue
+		}
+
+		if done(nil, sent) {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notify:
+		case <-ticker.C:
+		}
+	}
+}
+
+// newJobEvent converts the stored event to the JobEvent message.
+func newJobEvent(event *models.JobEvent) *services.JobEvent {
+	return &services.JobEvent{
+		Seq:           event.Seq,
+		JobId:         event.JobId,
+		Status:        event.Status,
+		Progress:      event.Progress,
+		Message:       event.Message,
+		ErrorCategory: event.ErrorCategory,
+		CreatedAt:     event.CreatedAt.Format(time.RFC3339),
+		Files:         newFileProgresses(event.Files),
+	}
+}
@@ -0,0 +1,265 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+	"transform2/config"
+	"transform2/models"
+	"transform2/service"
+	"transform2/services"
+	"transform2/webhook"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// webhookNotify wakes up the webhook deliverers when a delivery was queued.
+var webhookNotify = make(chan struct{}, 1)
+
+// SetWebhook registers the webhook of the organization of the caller.
+func SetWebhook(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_SetWebhookReq) (*services.S2C_SetWebhookRpn, error) {
+
+	log.Infof("Controller for Set Webhook")
+
+	if headers.TenantId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required to set a Webhook")
+	}
+	if err := webhook.ValidateURL(ctx, req.Url); err != nil {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, err.Error())
+	}
+
+	secret := req.Secret
+	if secret == "" {
+		var err error
+		if secret, err = webhook.NewSecret(); err != nil {
+			return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, err.Error())
+		}
+	}
+
+	if err := service.SetWebhook(ctx, models.NewWebhook(headers.TenantId, req.Url, secret, req.Disabled, time.Now())); err != nil {
+		return nil, err
+	}
+
+	return &services.S2C_SetWebhookRpn{
+		StatusCode: 200,
+		Message:    "Webhook Set",
+		Secret:     secret,
+	}, nil
+}
+
+// RemoveWebhook removes the webhook of the organization of the caller.
+func RemoveWebhook(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_RemoveWebhookReq) (*services.S2C_RemoveWebhookRpn, error) {
+
+	log.Infof("Controller for Remove Webhook")
+
+	if headers.TenantId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required to remove a Webhook")
+	}
+	if err := service.RemoveWebhook(ctx, headers.TenantId); err != nil {
+		return nil, err
+	}
+
+	return &services.S2C_RemoveWebhookRpn{
+		StatusCode: 200,
+		Message:    "Webhook Removed",
+	}, nil
+}
+
+// QueryWebhookDeliveries returns the delivery log of the organization of the caller.
+func QueryWebhookDeliveries(ctx context.Context, headers framework.CommonHeaders, req *services.C2S_QueryWebhookDeliveriesReq) (*services.S2C_QueryWebhookDeliveriesRpn, error) {
+
+	log.Infof("Controller for Query Webhook Deliveries")
+
+	if headers.TenantId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required to query Webhook Deliveries")
+	}
+
+	filter := bson.M{"TenantId": headers.TenantId}
+	if req.JobId != "" {
+		filter["JobId"] = req.JobId
+	}
+	if req.Status != "" {
+		filter["Status"] = req.Status
+	}
+	limit := int64(req.Limit)
+	if limit <= 0 {
+		limit = 100
+	}
+
+	deliveries, err := service.QueryWebhookDeliveries(ctx, filter, int64(req.Skip), limit)
+	if err != nil {
+		return nil, err
+	}
+
+	rpn := &services.S2C_QueryWebhookDeliveriesRpn{
+		StatusCode: 200,
+		Message:    fmt.Sprintf("%d Deliveries Found", len(deliveries)),
+	}
+	for _, delivery := range deliveries {
+		msg := &services.WebhookDelivery{
+			DeliveryId:   delivery.DeliveryId,
+			JobId:        delivery.JobId,
+			Url:          delivery.Url,
+			JobStatus:    delivery.JobStatus,
+			Status:       delivery.Status,
+			Attempts:     delivery.Attempts,
+			ResponseCode: delivery.ResponseCode,
+			Error:        delivery.Error,
+			CreatedAt:    delivery.CreatedAt.Format(time.RFC3339),
+			Payload:      delivery.Payload,
+		}
+		if delivery.Status == models.WebhookDeliveryPending {
+			msg.NextAttemptAt = delivery.NextAttemptAt.Format(time.RFC3339)
+		}
+		if !delivery.DeliveredAt.IsZero() {
+			msg.DeliveredAt = delivery.DeliveredAt.Format(time.RFC3339)
+		}
+		rpn.Deliveries = append(rpn.Deliveries, msg)
+	}
+
+	return rpn, nil
+}
+
+// enqueueWebhook queues the delivery of the current status of the job to its callback URL,
+// or to the webhook of its organization. Failures are only logged so they never fail the job.
+func enqueueWebhook(ctx context.Context, job *models.Job) {
+	url := job.CallbackUrl
+	if url == "" && job.TenantId != "" {
+		hook, err := service.GetWebhook(ctx, job.TenantId)
+		if err != nil {
+			log.Errorf("Failed to get the webhook of job %s: %v", job.JobId, err)
+			return
+		}
+		if hook != nil && !hook.Disabled {
+			url = hook.Url
+		}
+	}
+	if url == "" {
+		return
+	}
+
+	now := time.Now()
+	payload, err := json.Marshal(models.WebhookPayload{
+		Event:         models.WebhookEventJobStatus,
+		JobId:         job.JobId,
+		JobType:       job.JobType,
+		Status:        job.Status,
+		Progress:      job.Progress,
+		Message:       job.Message,
+		ErrorCategory: job.ErrorCategory,
+		RetryOf:       job.RetryOf,
+		Files:         job.Files,
+		OccurredAt:    now,
+	})
+	if err != nil {
+		log.Errorf("Failed to encode the webhook payload of job %s: %v", job.JobId, err)
+		return
+	}
+
+	if err := service.AddWebhookDelivery(ctx, &models.WebhookDelivery{
+		DeliveryId:    strconv.Itoa(int(GenerateID())),
+		JobId:         job.JobId,
+		TenantId:      job.TenantId,
+		Url:           url,
+		JobStatus:     job.Status,
+		Payload:       string(payload),
+		Status:        models.WebhookDeliveryPending,
+		CreatedAt:     now,
+		NextAttemptAt: now,
+	}); err != nil {
+		log.Errorf("Failed to queue the webhook delivery of job %s: %v", job.JobId, err)
+		return
+	}
+
+	select {
+	case webhookNotify <- struct{}{}:
+	default:
+	}
+}
+
+// DeliverWebhooks posts the pending webhook deliveries until the context is done. Failed attempts
+// are retried with exponential backoff until config.WebhookMaxAttempts, the deliveries are leased
+// so several server instances can deliver side by side.
+func DeliverWebhooks(ctx context.Context, interval time.Duration) {
+	log.Infof("Webhook deliverer started, interval %v", interval)
+
+	client := webhook.NewClient(config.WebhookTimeout)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Webhook deliverer stopped")
+			return
+		case <-ticker.C:
+		case <-webhookNotify:
+		}
+
+		workers := config.WebhookWorkers
+		if workers < 1 {
+			workers = 1
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				deliverDueWebhooks(ctx, client)
+			}()
+		}
+		wg.Wait()
+	}
+}
+
+// deliverDueWebhooks attempts the due deliveries one after the other until none is left.
+func deliverDueWebhooks(ctx context.Context, client *http.Client) {
+	for ctx.Err() == nil {
+		// The lease outlasts an attempt, an instance dying during an attempt only delays the delivery
+		delivery, err := service.ClaimWebhookDelivery(ctx, time.Now().Add(2*config.WebhookTimeout))
+		if err != nil || delivery == nil {
+			return
+		}
+		deliverWebhook(ctx, client, delivery)
+	}
+}
+
+// deliverWebhook makes one attempt of the delivery and stores its result. Payloads are signed with the
+// secret of the webhook of the organization, an organization without one fails the attempt.
+func deliverWebhook(ctx context.Context, client *http.Client, delivery *models.WebhookDelivery) {
+	var code int
+	hook, err := service.GetWebhook(ctx, delivery.TenantId)
+	if err == nil {
+		secret := ""
+		if hook != nil {
+			secret = hook.Secret
+		}
+		code, err = webhook.Post(ctx, client, delivery.Url, delivery.DeliveryId, secret, []byte(delivery.Payload))
+	}
+	now := time.Now()
+	delivery.Attempts++
+	delivery.ResponseCode = int32(code)
+	delivery.Error = ""
+
+	switch {
+	case err == nil:
+		delivery.Status = models.WebhookDeliveryDelivered
+		delivery.DeliveredAt = now
+	case delivery.Attempts >= config.WebhookMaxAttempts:
+		log.Warnf("Webhook delivery %s of job %s failed after %d attempts: %v", delivery.DeliveryId, delivery.JobId, delivery.Attempts, err)
+		delivery.Status = models.WebhookDeliveryFailed
+		delivery.Error = err.Error()
+	default:
+		delivery.Error = err.Error()
+		delivery.NextAttemptAt = now.Add(webhook.Backoff(int(delivery.Attempts), config.WebhookInitialBackoff, config.WebhookMaxBackoff))
+	}
+
+	if err := service.UpdateWebhookDelivery(ctx, delivery); err != nil {
+		log.Errorf("Failed to store the attempt of webhook delivery %s: %v", delivery.DeliveryId, err)
+	}
+}
@@ -0,0 +1,19 @@
+package dispatch
+
+// SplitBatch splits the entries of a batch into the accepted and the rejected ones, both in the order
+// of the batch. With allOrNothing a single rejected entry rejects the whole batch.
+func SplitBatch[T any](entries []T, rejected func(T) bool, allOrNothing bool) ([]T, []T) {
+	var accepted, rejections []T
+	for _, entry := range entries {
+		if rejected(entry) {
+			rejections = append(rejections, entry)
+		} else {
+			accepted = append(accepted, entry)
+		}
+	}
+
+	if len(rejections) > 0 && allOrNothing {
+		return nil, entries
+	}
+	return accepted, rejections
+}
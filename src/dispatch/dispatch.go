@@ -0,0 +1,92 @@
+// Package dispatch decides in which order and on which task queues jobs are started: the weighted
+// fair order of the organizations sharing a resource pool, the priority queues of VIP organizations
+// and the admission of the jobs of a batch. It only computes decisions, the controller stores them.
+package dispatch
+
+import (
+	"time"
+	"transform2/models"
+)
+
+// Fairness are the settings of the weighted fair order of the organizations sharing a pool.
+type Fairness struct {
+	Weights       map[string]int // Weights of the organizations
+	DefaultWeight int            // Weight of an organization without a weight, at least 1
+	AgingPeriod   time.Duration  // Time a job waits to count as one slot less, 0 does not age the jobs
+}
+
+// Queue hands out the waiting jobs of a pool, oldest first per organization, in the weighted fair order of the organizations.
+type Queue struct {
+	fairness Fairness
+	queues   map[string][]*models.Job // Waiting jobs of each organization, oldest first
+	running  map[string]int           // Slots used by each organization
+}
+
+// NewQueue queues the waiting jobs, they must be sorted by their creation time. running holds the slots
+// the organizations already use, it is updated as jobs are started.
+func NewQueue(waiting []*models.Job, running map[string]int, fairness Fairness) *Queue {
+	q := &Queue{fairness: fairness, queues: make(map[string][]*models.Job), running: running}
+	if q.running == nil {
+		q.running = make(map[string]int)
+	}
+	for _, job := range waiting {
+		q.queues[job.TenantId] = append(q.queues[job.TenantId], job)
+	}
+	return q
+}
+
+// Next removes and returns the next job for which fits returns true, nil if no waiting job fits.
+// Held jobs keep their place in the queue of their organization, an organization without a job
+// that fits is skipped until the queue is built again.
+func (q *Queue) Next(now time.Time, fits func(*models.Job) bool) *models.Job {
+	for len(q.queues) > 0 {
+		tenant := q.nextTenant(now)
+		for i, job := range q.queues[tenant] {
+			if fits(job) {
+				q.queues[tenant] = append(q.queues[tenant][:i:i], q.queues[tenant][i+1:]...)
+				if len(q.queues[tenant]) == 0 {
+					delete(q.queues, tenant)
+				}
+				return job
+			}
+		}
+		delete(q.queues, tenant)
+	}
+	return nil
+}
+
+// Started counts the slots of a started job against its organization.
+func (q *Queue) Started(job *models.Job, slots int) {
+	q.running[job.TenantId] += slots
+}
+
+// nextTenant picks the organization whose oldest waiting job is handed out next. Organizations are
+// ranked by the slots they would use relative to their weight, every aging period a job waits
+// counts as one slot less, so old jobs of low weight organizations eventually come first.
+// Ties go to the organization with the older job.
+func (q *Queue) nextTenant(now time.Time) string {
+	var next string
+	var best float64
+	found := false
+	for tenant, jobs := range q.queues {
+		score := float64(q.running[tenant]+1) / float64(q.fairness.weight(tenant))
+		if q.fairness.AgingPeriod > 0 {
+			score -= now.Sub(jobs[0].CreatedAt).Seconds() / q.fairness.AgingPeriod.Seconds()
+		}
+		if !found || score < best || (score == best && jobs[0].CreatedAt.Before(q.queues[next][0].CreatedAt)) {
+			next, best, found = tenant, score, true
+		}
+	}
+	return next
+}
+
+// weight returns the weight of the organization, the default weight if it has none.
+func (f *Fairness) weight(tenant string) int {
+	if weight, ok := f.Weights[tenant]; ok && weight > 0 {
+		return weight
+	}
+	if f.DefaultWeight > 0 {
+		return f.DefaultWeight
+	}
+	return 1
+}
@@ -0,0 +1,193 @@
+package dispatch
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"google.golang.org/grpc/metadata"
+)
+
+var start = time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+// job returns a job of the tenant created the given number of seconds after start.
+func job(id string, tenant string, created int) *models.Job {
+	return &models.Job{JobId: id, TenantId: tenant, CreatedAt: start.Add(time.Duration(created) * time.Second)}
+}
+
+// order hands out all jobs of the queue, every job taking one slot.
+func order(q *Queue, now time.Time, fits func(*models.Job) bool) []string {
+	var ids []string
+	for next := q.Next(now, fits); next != nil; next = q.Next(now, fits) {
+		ids = append(ids, next.JobId)
+		q.Started(next, 1)
+	}
+	return ids
+}
+
+func all(*models.Job) bool { return true }
+
+func TestQueueOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		waiting  []*models.Job
+		running  map[string]int
+		fairness Fairness
+		now      time.Time
+		want     []string
+	}{
+		{
+			name:    "equal weights alternate",
+			waiting: []*models.Job{job("a1", "a", 0), job("a2", "a", 1), job("a3", "a", 2), job("b1", "b", 3), job("b2", "b", 4)},
+			now:     start.Add(5 * time.Second),
+			want:    []string{"a1", "b1", "a2", "b2", "a3"},
+		},
+		{
+			name:     "weights share the slots",
+			waiting:  []*models.Job{job("a1", "a", 0), job("a2", "a", 1), job("a3", "a", 2), job("a4", "a", 3), job("b1", "b", 4), job("b2", "b", 5)},
+			fairness: Fairness{Weights: map[string]int{"a": 3}},
+			now:      start.Add(6 * time.Second),
+			want:     []string{"a1", "a2", "a3", "b1", "a4", "b2"},
+		},
+		{
+			name:     "default weight",
+			waiting:  []*models.Job{job("a1", "a", 0), job("a2", "a", 1), job("b1", "b", 2), job("b2", "b", 3)},
+			fairness: Fairness{Weights: map[string]int{"b": 1}, DefaultWeight: 2},
+			now:      start.Add(4 * time.Second),
+			want:     []string{"a1", "a2", "b1", "b2"},
+		},
+		{
+			name:    "running slots count",
+			waiting: []*models.Job{job("a1", "a", 0), job("b1", "b", 1)},
+			running: map[string]int{"a": 2},
+			now:     start.Add(2 * time.Second),
+			want:    []string{"b1", "a1"},
+		},
+		{
+			name:     "aging lets old jobs of light organizations pass",
+			waiting:  []*models.Job{job("a1", "a", 0), job("b1", "b", 600)},
+			running:  map[string]int{"a": 5},
+			fairness: Fairness{AgingPeriod: time.Minute},
+			now:      start.Add(660 * time.Second),
+			want:     []string{"a1", "b1"},
+		},
+		{
+			name:     "without aging old jobs wait",
+			waiting:  []*models.Job{job("a1", "a", 0), job("b1", "b", 600)},
+			running:  map[string]int{"a": 5},
+			fairness: Fairness{},
+			now:      start.Add(660 * time.Second),
+			want:     []string{"b1", "a1"},
+		},
+		{
+			name:    "ties go to the older job",
+			waiting: []*models.Job{job("b1", "b", 1), job("a1", "a", 0)},
+			now:     start.Add(2 * time.Second),
+			want:    []string{"a1", "b1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			running := make(map[string]int)
+			for tenant, slots := range test.running {
+				running[tenant] = slots
+			}
+			got := order(NewQueue(test.waiting, running, test.fairness), test.now, all)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("order = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestQueueHeldJobs(t *testing.T) {
+	waiting := []*models.Job{job("a1", "a", 0), job("a2", "a", 1), job("b1", "b", 2), job("a3", "a", 3)}
+	q := NewQueue(waiting, nil, Fairness{})
+
+	// a1 is too large, a2 passes it and a1 keeps its place in the queue of a
+	held := map[string]bool{"a1": true}
+	fits := func(job *models.Job) bool { return !held[job.JobId] }
+	if got, want := order(q, start, fits), []string{"a2", "b1", "a3"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("order = %v, want %v", got, want)
+	}
+	if next := q.Next(start, all); next != nil {
+		t.Errorf("Next = %s after the organization was skipped, want nil", next.JobId)
+	}
+
+	q = NewQueue(waiting, nil, Fairness{})
+	if next := q.Next(start, func(*models.Job) bool { return false }); next != nil {
+		t.Errorf("Next = %s without a job that fits, want nil", next.JobId)
+	}
+}
+
+func TestSplitBatch(t *testing.T) {
+	errRejected := errors.New("rejected")
+	tests := []struct {
+		name         string
+		errs         []error
+		allOrNothing bool
+		accepted     []int
+		rejected     []int
+	}{
+		{"all accepted", []error{nil, nil}, true, []int{0, 1}, nil},
+		{"partial", []error{nil, errRejected, nil}, false, []int{0, 2}, []int{1}},
+		{"all or nothing", []error{nil, errRejected, nil}, true, nil, []int{0, 1, 2}},
+		{"empty", nil, true, nil, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var entries []int
+			for i := range test.errs {
+				entries = append(entries, i)
+			}
+			accepted, rejected := SplitBatch(entries, func(i int) bool { return test.errs[i] != nil }, test.allOrNothing)
+			if !reflect.DeepEqual(accepted, test.accepted) || !reflect.DeepEqual(rejected, test.rejected) {
+				t.Errorf("SplitBatch = %v, %v, want %v, %v", accepted, rejected, test.accepted, test.rejected)
+			}
+		})
+	}
+}
+
+func TestPriority(t *testing.T) {
+	tiers := map[string]interface{}{"vip": "VIP", "normal": "Normal", "invalid": 1}
+	tests := []struct {
+		tenant    string
+		priority  string
+		taskQueue string
+	}{
+		{"vip", models.PriorityVIP, "zcad-vip"},
+		{"normal", models.PriorityNormal, "zcad"},
+		{"invalid", models.PriorityNormal, "zcad"},
+		{"unknown", models.PriorityNormal, "zcad"},
+	}
+
+	for _, test := range tests {
+		priority := Priority(tiers, test.tenant)
+		if priority != test.priority {
+			t.Errorf("Priority(%s) = %s, want %s", test.tenant, priority, test.priority)
+		}
+		if taskQueue := TaskQueue("zcad", priority, "-vip"); taskQueue != test.taskQueue {
+			t.Errorf("TaskQueue of %s = %s, want %s", test.tenant, taskQueue, test.taskQueue)
+		}
+	}
+	if priority := Priority(nil, "vip"); priority != models.PriorityNormal {
+		t.Errorf("Priority without tiers = %s, want %s", priority, models.PriorityNormal)
+	}
+}
+
+func TestPriorityOfCaller(t *testing.T) {
+	// The organization comes from the metadata of the call, gRPC sends its keys in lower case
+	var headers framework.CommonHeaders
+	if err := framework.DoTestRpcHeaders(metadata.Pairs("zixel-organization-id", "vip"), &headers); err != nil {
+		t.Fatalf("DoTestRpcHeaders: %v", err)
+	}
+	tiers := map[string]interface{}{"vip": "VIP"}
+	if priority := Priority(tiers, headers.TenantId); priority != models.PriorityVIP {
+		t.Errorf("Priority of the caller %q = %s, want %s", headers.TenantId, priority, models.PriorityVIP)
+	}
+}
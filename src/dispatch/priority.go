@@ -0,0 +1,20 @@
+package dispatch
+
+import "transform2/models"
+
+// Priority returns the priority tier configured for the organization in tiers, Normal if none is configured.
+func Priority(tiers map[string]interface{}, tenantId string) string {
+	if tier, ok := tiers[tenantId].(string); ok && tier == models.PriorityVIP {
+		return models.PriorityVIP
+	}
+	return models.PriorityNormal
+}
+
+// TaskQueue returns the task queue of the job type that serves jobs of the priority,
+// VIP jobs go to the queue of the job type with the suffix.
+func TaskQueue(taskQueue string, priority string, vipSuffix string) string {
+	if priority == models.PriorityVIP {
+		return taskQueue + vipSuffix
+	}
+	return taskQueue
+}
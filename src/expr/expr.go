@@ -0,0 +1,309 @@
+// Package expr evaluates the expressions of job type scripts. Expressions only compute a value from
+// the variables they are given with a few builtin functions, they cannot call into the system.
+package expr
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode"
+)
+
+// Limits of an expression, they keep parsing and evaluating an untrusted expression cheap.
+const (
+	MaxLength = 4096 // Longest source of an expression
+	MaxDepth  = 64   // Deepest nesting of parentheses, negations and function calls
+)
+
+// ErrDivisionByZero is returned when an expression divides by zero.
+var ErrDivisionByZero = errors.New("division by zero")
+
+// function is a builtin function an expression can call.
+type function struct {
+	minArgs, maxArgs int // Accepted number of arguments, maxArgs -1 accepts any number
+	call             func(args []float64) float64
+}
+
+// functions are the only functions an expression can call.
+var functions = map[string]function{
+	"min": {1, -1, func(args []float64) float64 {
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = math.Min(result, arg)
+		}
+		return result
+	}},
+	"max": {1, -1, func(args []float64) float64 {
+		result := args[0]
+		for _, arg := range args[1:] {
+			result = math.Max(result, arg)
+		}
+		return result
+	}},
+	"ceil":  {1, 1, func(args []float64) float64 { return math.Ceil(args[0]) }},
+	"floor": {1, 1, func(args []float64) float64 { return math.Floor(args[0]) }},
+}
+
+// Expr is a parsed expression.
+type Expr struct {
+	src  string
+	root node
+	vars []string
+}
+
+// node is an element of the syntax tree of an expression.
+type node interface {
+	eval(vars map[string]float64) (float64, error)
+}
+
+type number float64
+
+type variable string
+
+type negation struct {
+	operand node
+}
+
+type binary struct {
+	op          byte
+	left, right node
+}
+
+type call struct {
+	fn   function
+	args []node
+}
+
+// Parse parses an arithmetic expression of numbers, variables, + - * / and parentheses.
+// The functions min, max, ceil and floor can be called, e.g. max(1, ceil(size_mb / 100)).
+func Parse(src string) (*Expr, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("expression is longer than %d characters", MaxLength)
+	}
+	p := &parser{src: src}
+	root, err := p.parseSum()
+	if err == nil && p.peek() != 0 {
+		err = p.errorf("unexpected %q", p.peek())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Expr{src: src, root: root, vars: p.vars}, nil
+}
+
+// String returns the source of the expression.
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Vars returns the variables the expression refers to, in the order they first appear.
+func (e *Expr) Vars() []string {
+	return e.vars
+}
+
+// Eval computes the value of the expression, all its variables must be given.
+func (e *Expr) Eval(vars map[string]float64) (float64, error) {
+	return e.root.eval(vars)
+}
+
+func (n number) eval(map[string]float64) (float64, error) {
+	return float64(n), nil
+}
+
+func (v variable) eval(vars map[string]float64) (float64, error) {
+	value, ok := vars[string(v)]
+	if !ok {
+		return 0, fmt.Errorf("unknown variable %s", string(v))
+	}
+	return value, nil
+}
+
+func (n *negation) eval(vars map[string]float64) (float64, error) {
+	value, err := n.operand.eval(vars)
+	return -value, err
+}
+
+func (b *binary) eval(vars map[string]float64) (float64, error) {
+	left, err := b.left.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	right, err := b.right.eval(vars)
+	if err != nil {
+		return 0, err
+	}
+
+	switch b.op {
+	case '+':
+		return left + right, nil
+	case '-':
+		return left - right, nil
+	case '*':
+		return left * right, nil
+	default:
+		if right == 0 {
+			return 0, ErrDivisionByZero
+		}
+		return left / right, nil
+	}
+}
+
+func (c *call) eval(vars map[string]float64) (float64, error) {
+	args := make([]float64, len(c.args))
+	for i, arg := range c.args {
+		value, err := arg.eval(vars)
+		if err != nil {
+			return 0, err
+		}
+		args[i] = value
+	}
+	return c.fn.call(args), nil
+}
+
+// parser is a recursive descent parser over the source of an expression.
+type parser struct {
+	src   string
+	pos   int
+	depth int
+	vars  []string
+}
+
+// peek skips white space and returns the next character, 0 at the end of the source.
+func (p *parser) peek() byte {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+	if p.pos == len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("expression %q at %d: %s", p.src, p.pos, fmt.Sprintf(format, args...))
+}
+
+// parseSum parses terms joined by + and -.
+func (p *parser) parseSum() (node, error) {
+	left, err := p.parseProduct()
+	for err == nil && (p.peek() == '+' || p.peek() == '-') {
+		op := p.src[p.pos]
+		p.pos++
+		var right node
+		if right, err = p.parseProduct(); err == nil {
+			left = &binary{op: op, left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+// parseProduct parses factors joined by * and /.
+func (p *parser) parseProduct() (node, error) {
+	left, err := p.parseFactor()
+	for err == nil && (p.peek() == '*' || p.peek() == '/') {
+		op := p.src[p.pos]
+		p.pos++
+		var right node
+		if right, err = p.parseFactor(); err == nil {
+			left = &binary{op: op, left: left, right: right}
+		}
+	}
+	return left, err
+}
+
+// parseFactor parses a number, a variable, a function call, a negated factor or a parenthesized expression.
+func (p *parser) parseFactor() (node, error) {
+	p.depth++
+	defer func() { p.depth-- }()
+	if p.depth > MaxDepth {
+		return nil, p.errorf("nested deeper than %d", MaxDepth)
+	}
+
+	c := p.peek()
+	switch {
+	case c == 0:
+		return nil, p.errorf("unexpected end")
+	case c == '-':
+		p.pos++
+		operand, err := p.parseFactor()
+		return &negation{operand: operand}, err
+	case c == '(':
+		p.pos++
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, p.errorf("missing )")
+		}
+		p.pos++
+		return inner, nil
+	case c == '.' || c >= '0' && c <= '9':
+		start := p.pos
+		for p.pos < len(p.src) && (p.src[p.pos] == '.' || p.src[p.pos] >= '0' && p.src[p.pos] <= '9') {
+			p.pos++
+		}
+		value, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %s", p.src[start:p.pos])
+		}
+		return number(value), nil
+	case isIdentStart(c):
+		start := p.pos
+		for p.pos < len(p.src) && (isIdentStart(p.src[p.pos]) || p.src[p.pos] >= '0' && p.src[p.pos] <= '9') {
+			p.pos++
+		}
+		name := p.src[start:p.pos]
+		if p.peek() == '(' {
+			return p.parseCall(name)
+		}
+		p.addVar(name)
+		return variable(name), nil
+	}
+	return nil, p.errorf("unexpected %q", c)
+}
+
+// parseCall parses the arguments of a call of the named function, the opening parenthesis is next.
+func (p *parser) parseCall(name string) (node, error) {
+	fn, ok := functions[name]
+	if !ok {
+		return nil, p.errorf("unknown function %s", name)
+	}
+	p.pos++
+
+	n := &call{fn: fn}
+	for p.peek() != ')' {
+		if len(n.args) > 0 {
+			if p.peek() != ',' {
+				return nil, p.errorf("missing , or )")
+			}
+			p.pos++
+		}
+		arg, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		n.args = append(n.args, arg)
+	}
+	p.pos++
+
+	if len(n.args) < fn.minArgs || fn.maxArgs >= 0 && len(n.args) > fn.maxArgs {
+		return nil, p.errorf("wrong number of arguments for %s", name)
+	}
+	return n, nil
+}
+
+// addVar records a variable the expression refers to.
+func (p *parser) addVar(name string) {
+	for _, v := range p.vars {
+		if v == name {
+			return
+		}
+	}
+	p.vars = append(p.vars, name)
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
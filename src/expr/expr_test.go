@@ -0,0 +1,75 @@
+package expr
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	vars := map[string]float64{"done": 3, "total": 4, "size_mb": 12.5}
+	tests := []struct {
+		src  string
+		want float64
+	}{
+		{"42", 42},
+		{"1 + 2 * 3", 7},
+		{"(1 + 2) * 3", 9},
+		{"10 - 4 - 3", 3},
+		{"8 / 4 / 2", 1},
+		{"-2 * -3", 6},
+		{"done / total * 100", 75},
+		{" size_mb*2 ", 25},
+		{".5 + 0.25", 0.75},
+		{"max(1, ceil(size_mb / 10))", 2},
+		{"min(done, total, 2) + floor(-0.5)", 1},
+	}
+	for _, tt := range tests {
+		e, err := Parse(tt.src)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.src, err)
+			continue
+		}
+		got, err := e.Eval(vars)
+		if err != nil {
+			t.Errorf("Eval(%q): %v", tt.src, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Eval(%q) = %v, want %v", tt.src, got, tt.want)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, src := range []string{"", "1 +", "(1 + 2", "1 2", "a % b", "1..2", "os.Exit(1)", "exec(1)", "ceil(1, 2)", "max()", "min(1 2)",
+		strings.Repeat("(", MaxDepth+1) + "1" + strings.Repeat(")", MaxDepth+1), strings.Repeat("1+", MaxLength)} {
+		if _, err := Parse(src); err == nil {
+			t.Errorf("Parse(%q) succeeded, want an error", src)
+		}
+	}
+}
+
+func TestVars(t *testing.T) {
+	e, err := Parse("(b + a) / max(b, c) * c")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if got, want := e.Vars(), []string{"b", "a", "c"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Vars() = %v, want %v", got, want)
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	e, err := Parse("done / total")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if _, err := e.Eval(map[string]float64{"done": 1, "total": 0}); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("Eval with total 0: err = %v, want %v", err, ErrDivisionByZero)
+	}
+	if _, err := e.Eval(map[string]float64{"done": 1}); err == nil {
+		t.Errorf("Eval without total succeeded, want an error")
+	}
+}
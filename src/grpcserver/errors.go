@@ -0,0 +1,59 @@
+package grpcserver
+
+import (
+	"strconv"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain is the domain of the ErrorInfo details attached to the gRPC errors.
+const errorDomain = "transform"
+
+// grpcCodes maps the job error categories to gRPC status codes.
+var grpcCodes = map[string]codes.Code{
+	models.ErrorInvalidRequest:        codes.InvalidArgument,
+	models.ErrorUnsupportedFormat:     codes.InvalidArgument,
+	models.ErrorQueueFull:             codes.ResourceExhausted,
+	models.ErrorInsufficientResources: codes.ResourceExhausted,
+	models.ErrorConversionFailed:      codes.Internal,
+	models.ErrorTimeout:               codes.DeadlineExceeded,
+	models.ErrorCancelled:             codes.Canceled,
+	models.ErrorInternal:              codes.Internal,
+	models.ErrorQuotaExceeded:         codes.ResourceExhausted,
+	models.ErrorNotFound:              codes.NotFound,
+}
+
+// statusError converts a controller error to a gRPC status error, the ErrorInfo details carry
+// the job error category as reason and the error code, so clients can tell the failures apart.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	category := config.ErrorCategory(err)
+	code := framework.ERR_SYS_SERVER
+	if serviceErr, ok := err.(framework.ServiceError); ok {
+		code = serviceErr.Code
+	}
+
+	st := status.New(grpcCodes[category], err.Error())
+	withDetails, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason:   category,
+		Domain:   errorDomain,
+		Metadata: map[string]string{"code": strconv.Itoa(code)},
+	})
+	if detailsErr != nil {
+		log.Errorf("Failed to attach error details: %v", detailsErr)
+		return st.Err()
+	}
+
+	return withDetails.Err()
+}
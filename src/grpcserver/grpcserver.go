@@ -1,7 +1,9 @@
 package grpcserver
 
 import (
+	"context"
 	"transform2/config"
+	"transform2/controller"
 	"transform2/services"
 
 	"gitlab.zixel.cn/go/framework"
@@ -31,6 +33,21 @@ func Init() error {
 	framework.RegisterService(&services.JobManagement_ServiceDesc, &JobManageServer{})
 	framework.RegisterService(&services.ResourcePoolManagement_ServiceDesc, &ResourcePoolServer{})
 	framework.RegisterService(&services.TenantManagement_ServiceDesc, &TenantConfigServer{})
+	framework.RegisterService(&services.QuotaManagement_ServiceDesc, &QuotaServer{})
+	framework.RegisterService(&services.UsageMetering_ServiceDesc, &UsageServer{})
+	framework.RegisterService(&services.WebhookManagement_ServiceDesc, &WebhookServer{})
 	config.InitMongoDB()
+
+	// the runs of recurring jobs are submitted by this server
+	if err := controller.StartScheduleWorker(c); err != nil {
+		log.Fatalln("Unable to start the schedule worker", err)
+		return err
+	}
+
+	// keep the status of unfinished jobs in sync with their workflows
+	go controller.TrackJobs(context.Background(), c, config.JobSyncInterval)
+	go controller.DispatchJobs(context.Background(), c, config.JobSyncInterval)
+	go controller.DeliverWebhooks(context.Background(), config.JobSyncInterval)
+	go controller.PublishJobEvents(context.Background(), config.JobSyncInterval)
 	return nil
 }
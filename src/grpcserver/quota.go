@@ -0,0 +1,91 @@
+package grpcserver
+
+import (
+	"context"
+	"transform2/controller"
+	"transform2/services"
+)
+
+type QuotaServer struct {
+	services.UnimplementedQuotaManagementServer
+}
+
+// Add a quota of an organization or a user
+func (s *QuotaServer) AddQuota(ctx context.Context, req *services.C2S_AddQuotaReq) (*services.S2C_AddQuotaRpn, error) {
+	log.Infof("Request Came for Add Quota")
+	var rpn services.S2C_AddQuotaRpn
+
+	//Pass the Request to the Controller
+	response, err := controller.AddQuota(ctx, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
+
+// Remove a quota
+func (s *QuotaServer) RemoveQuota(ctx context.Context, req *services.C2S_RemoveQuotaReq) (*services.S2C_RemoveQuotaRpn, error) {
+	log.Infof("Request Came for Remove Quota")
+	var rpn services.S2C_RemoveQuotaRpn
+
+	//Pass the Request to the Controller
+	response, err := controller.RemoveQuota(ctx, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
+
+// Update the settings of a quota
+func (s *QuotaServer) SetQuota(ctx context.Context, req *services.C2S_SetQuotaReq) (*services.S2C_SetQuotaRpn, error) {
+	log.Infof("Request Came for Set Quota")
+	var rpn services.S2C_SetQuotaRpn
+
+	//Pass the Request to the Controller
+	response, err := controller.SetQuota(ctx, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
+
+// Get a quota
+func (s *QuotaServer) GetQuota(ctx context.Context, req *services.C2S_GetQuotaReq) (*services.S2C_GetQuotaRpn, error) {
+	log.Infof("Request Came for Get Quota")
+	var rpn services.S2C_GetQuotaRpn
+
+	//Pass the Request to the Controller
+	response, err := controller.GetQuota(ctx, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
+
+// Query the quotas of an organization or a user
+func (s *QuotaServer) QueryQuota(ctx context.Context, req *services.C2S_QueryQuotaReq) (*services.S2C_QueryQuotaRpn, error) {
+	log.Infof("Request Came for Query Quota")
+	var rpn services.S2C_QueryQuotaRpn
+
+	//Pass the Request to the Controller
+	response, err := controller.QueryQuota(ctx, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"github.com/golang/protobuf/proto"
+	"gitlab.zixel.cn/go/framework"
 	"transform2/controller"
 	"transform2/services"
 )
@@ -96,3 +97,39 @@ func (s *ResourcePoolServer) QueryResourcePool(ctx context.Context, req *service
 
 	return rpn, nil
 }
+
+func (s *ResourcePoolServer) StartResourcePool(ctx context.Context, req *services.C2S_StartResourcePoolReqT) (*services.C2S_StartResourcePoolRpnT, error) {
+	log.Infof("Request Came for Start Resource Pool")
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		return &services.C2S_StartResourcePoolRpnT{StatusCode: "500", Message: err.Error()}, nil
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.StartResourcePool(ctx, headers, req)
+	if err != nil {
+		return &services.C2S_StartResourcePoolRpnT{StatusCode: "500", Message: err.Error()}, nil
+	}
+
+	return response, nil
+}
+
+func (s *ResourcePoolServer) StopResourcePool(ctx context.Context, req *services.C2S_StopResourcePoolReqT) (*services.C2S_StopResourcePoolRpnT, error) {
+	log.Infof("Request Came for Stop Resource Pool")
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		return &services.C2S_StopResourcePoolRpnT{StatusCode: "500", Message: err.Error()}, nil
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.StopResourcePool(ctx, headers, req)
+	if err != nil {
+		return &services.C2S_StopResourcePoolRpnT{StatusCode: "500", Message: err.Error()}, nil
+	}
+
+	return response, nil
+}
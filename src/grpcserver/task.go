@@ -2,17 +2,15 @@ package grpcserver
 
 import (
 	"context"
-	"fmt"
-	"transform2/config"
+	"transform2/controller"
 	"transform2/services"
 
 	"gitlab.zixel.cn/go/framework"
-	"go.temporal.io/sdk/client"
 )
 
-// Grpc Request will execute the Workflow
+// Grpc Request will store the Job and start its Workflow
 func (s *TransformServer) CreateJob(ctx context.Context, req *services.C2S_CreateJobReq) (*services.S2C_CreateJobRpn, error) {
-	fmt.Println("Request Came")
+	log.Infof("Request Came for Create Job")
 	var rpn services.S2C_CreateJobRpn
 
 	var headers framework.CommonHeaders
@@ -20,44 +18,257 @@ func (s *TransformServer) CreateJob(ctx context.Context, req *services.C2S_Creat
 		log.Error(err.Error())
 		rpn.StatusCode = 500
 		rpn.Message = err.Error()
-		return &rpn, err
+		return &rpn, statusError(err)
 	}
 
-	switch req.JobType {
-	case 0:
-		log.Infof("ZCAD Request %v, %v", req, req.Parameters)
-		workflowOptions := client.StartWorkflowOptions{
-			ID:        config.RandomString(32),
-			TaskQueue: "zcad-queue",
-		}
+	//Pass the Request to the Controller
+	response, err := controller.CreateJob(ctx, s.WorkflowClient, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	log.Infof("Job %s Created", response.JobID)
+	return response, nil
+}
 
-		log.Debug("Starting Workflow")
-		reply, err := s.WorkflowClient.ExecuteWorkflow(ctx, workflowOptions, "ScheduleWorkflow", req.StorageToken, req.Parameters)
-		if err != nil {
-			log.Errorf("Failed to start workflow: %v", err)
-			return nil, err
-		}
+// Grpc Request will store the Jobs of the batch and start their Workflows
+func (s *TransformServer) CreateJobs(ctx context.Context, req *services.C2S_CreateJobsReq) (*services.S2C_CreateJobsRpn, error) {
+	log.Infof("Request Came for Create Jobs")
+	var rpn services.S2C_CreateJobsRpn
 
-		var res error
-		if err := reply.Get(ctx, &res); err != nil {
-			log.Errorf("Failed to Get Result: %v", err)
-			return nil, err
-		}
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
 
-		log.Infof("Result of the Request %v", rpn)
+	//Pass the Request to the Controller
+	response, err := controller.CreateJobs(ctx, s.WorkflowClient, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
 	}
 
-	return nil, nil
+	log.Info(response.Message)
+	return response, nil
 }
 
 // Get task details
-func (s *TransformServer) GetJobInfo(context.Context, *services.C2S_GetJobInfoReq) (*services.S2C_GetJobInfoRpn, error) {
-	return nil, nil
+func (s *TransformServer) GetJobInfo(ctx context.Context, req *services.C2S_GetJobInfoReq) (*services.S2C_GetJobInfoRpn, error) {
+	log.Infof("Request Came for Get Job Info")
+	var rpn services.S2C_GetJobInfoRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.GetJobInfo(ctx, s.WorkflowClient, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Return the Response
+	return response, nil
+}
+
+// List the Tasks of the organization
+func (s *TransformServer) ListJobs(ctx context.Context, req *services.C2S_ListJobsReq) (*services.S2C_ListJobsRpn, error) {
+	log.Infof("Request Came for List Jobs")
+	var rpn services.S2C_ListJobsRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.ListJobs(ctx, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Return the Response
+	return response, nil
 }
 
 // Cancel a Task
-func (s *TransformServer) CancelJob(context.Context, *services.C2S_CancelJobReq) (*services.S2C_CancelJobRpn, error) {
-	return nil, nil
+func (s *TransformServer) CancelJob(ctx context.Context, req *services.C2S_CancelJobReq) (*services.S2C_CancelJobRpn, error) {
+	log.Infof("Request Came for Cancel Job")
+	var rpn services.S2C_CancelJobRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.CancelJob(ctx, s.WorkflowClient, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Return the Response
+	return response, nil
+}
+
+// Pause a Task, it dispatches no further files
+func (s *TransformServer) PauseJob(ctx context.Context, req *services.C2S_PauseJobReq) (*services.S2C_PauseJobRpn, error) {
+	log.Infof("Request Came for Pause Job")
+	var rpn services.S2C_PauseJobRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.PauseJob(ctx, s.WorkflowClient, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Return the Response
+	return response, nil
+}
+
+// Resume a paused Task
+func (s *TransformServer) ResumeJob(ctx context.Context, req *services.C2S_ResumeJobReq) (*services.S2C_ResumeJobRpn, error) {
+	log.Infof("Request Came for Resume Job")
+	var rpn services.S2C_ResumeJobRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.ResumeJob(ctx, s.WorkflowClient, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Return the Response
+	return response, nil
+}
+
+// Rerun the failed files of a Task
+func (s *TransformServer) RetryJob(ctx context.Context, req *services.C2S_RetryJobReq) (*services.S2C_RetryJobRpn, error) {
+	log.Infof("Request Came for Retry Job")
+	var rpn services.S2C_RetryJobRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.RetryJob(ctx, s.WorkflowClient, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Return the Response
+	return response, nil
+}
+
+// Get the queue position and predicted times of a Task
+func (s *TransformServer) GetJobQueueInfo(ctx context.Context, req *services.C2S_GetJobQueueInfoReq) (*services.S2C_GetJobQueueInfoRpn, error) {
+	log.Infof("Request Came for Get Job Queue Info")
+	var rpn services.S2C_GetJobQueueInfoRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.GetJobQueueInfo(ctx, s.WorkflowClient, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Return the Response
+	return response, nil
+}
+
+// Stream the status and progress changes of a Task
+func (s *TransformServer) WatchJob(req *services.C2S_WatchJobReq, stream services.TransformV2_WatchJobServer) error {
+	log.Infof("Request Came for Watch Job")
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(stream.Context(), &headers); err != nil {
+		log.Error(err.Error())
+		return statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	if err := controller.WatchJob(stream.Context(), headers, req, stream.Send); err != nil {
+		log.Errorf("Watch Job %s stopped: %v", req.JobId, err)
+		return statusError(err)
+	}
+
+	return nil
+}
+
+// Stream the status and progress changes of all Tasks of the organization
+func (s *TransformServer) WatchJobs(req *services.C2S_WatchJobsReq, stream services.TransformV2_WatchJobsServer) error {
+	log.Infof("Request Came for Watch Jobs")
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(stream.Context(), &headers); err != nil {
+		log.Error(err.Error())
+		return statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	if err := controller.WatchJobs(stream.Context(), headers, req, stream.Send); err != nil {
+		log.Errorf("Watch Jobs stopped: %v", err)
+		return statusError(err)
+	}
+
+	return nil
 }
 
 func (s *TransformServer) mustEmbedUnimplementedTransformV2Server() {}
@@ -0,0 +1,61 @@
+package grpcserver
+
+import (
+	"context"
+	"transform2/controller"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+)
+
+type UsageServer struct {
+	services.UnimplementedUsageMeteringServer
+}
+
+// Get the usage per organization and billing period
+func (s *UsageServer) GetUsage(ctx context.Context, req *services.C2S_GetUsageReq) (*services.S2C_GetUsageRpn, error) {
+	log.Infof("Request Came for Get Usage")
+	var rpn services.S2C_GetUsageRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.GetUsage(ctx, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
+
+// Export the usage per organization and billing period as CSV file
+func (s *UsageServer) ExportUsage(ctx context.Context, req *services.C2S_GetUsageReq) (*services.S2C_ExportUsageRpn, error) {
+	log.Infof("Request Came for Export Usage")
+	var rpn services.S2C_ExportUsageRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.ExportUsage(ctx, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,85 @@
+package grpcserver
+
+import (
+	"context"
+	"transform2/controller"
+	"transform2/services"
+
+	"gitlab.zixel.cn/go/framework"
+)
+
+type WebhookServer struct {
+	services.UnimplementedWebhookManagementServer
+}
+
+// Register the webhook of the organization of the caller
+func (s *WebhookServer) SetWebhook(ctx context.Context, req *services.C2S_SetWebhookReq) (*services.S2C_SetWebhookRpn, error) {
+	log.Infof("Request Came for Set Webhook")
+	var rpn services.S2C_SetWebhookRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.SetWebhook(ctx, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
+
+// Remove the webhook of the organization of the caller
+func (s *WebhookServer) RemoveWebhook(ctx context.Context, req *services.C2S_RemoveWebhookReq) (*services.S2C_RemoveWebhookRpn, error) {
+	log.Infof("Request Came for Remove Webhook")
+	var rpn services.S2C_RemoveWebhookRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.RemoveWebhook(ctx, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
+
+// Query the webhook delivery log of the organization of the caller
+func (s *WebhookServer) QueryWebhookDeliveries(ctx context.Context, req *services.C2S_QueryWebhookDeliveriesReq) (*services.S2C_QueryWebhookDeliveriesRpn, error) {
+	log.Infof("Request Came for Query Webhook Deliveries")
+	var rpn services.S2C_QueryWebhookDeliveriesRpn
+
+	var headers framework.CommonHeaders
+	if err := framework.GetCommonHeaders(ctx, &headers); err != nil {
+		log.Error(err.Error())
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	//Pass the Request to the Controller
+	response, err := controller.QueryWebhookDeliveries(ctx, headers, req)
+	if err != nil {
+		rpn.StatusCode = 500
+		rpn.Message = err.Error()
+		return &rpn, statusError(err)
+	}
+
+	return response, nil
+}
@@ -0,0 +1,9 @@
+package models
+
+// Equity holds the scheduling rights of an organization, it is shared with the v1 service.
+type Equity struct {
+	EquityId string `json:"equityId" bson:"_id"`      // Unique identifier of the equity
+	TenantId string `json:"tenantId" bson:"tenantId"` // Organization the equity belongs to
+	Weight   int    `json:"weight" bson:"weight"`     // Share of the worker slots of a shared pool
+	IsEnable bool   `json:"isEnable" bson:"isEnable"` // Whether the equity is in effect
+}
@@ -0,0 +1,50 @@
+package models
+
+import (
+	"errors"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+// Categories of job failures. Activities raise them as the type of Temporal application errors,
+// the server reports them on the job and in the details of gRPC errors.
+const (
+	ErrorInvalidRequest        = "InvalidRequest"        // Request is malformed or references unknown data
+	ErrorUnsupportedFormat     = "UnsupportedFormat"     // Input file format is not supported by the converter
+	ErrorQueueFull             = "QueueFull"             // Queue of the job type or pool is full
+	ErrorInsufficientResources = "InsufficientResources" // No resources are available to run the job
+	ErrorConversionFailed      = "ConversionFailed"      // Converter failed on the input file
+	ErrorTimeout               = "Timeout"               // Job or activity ran out of time
+	ErrorCancelled             = "Cancelled"             // Job was cancelled
+	ErrorInternal              = "Internal"              // Unexpected failure of the service or the worker
+	ErrorQuotaExceeded         = "QuotaExceeded"         // Quota of the organization or user does not allow the job
+	ErrorNotFound              = "NotFound"              // Job does not exist or belongs to another organization
+)
+
+// ErrorCategories lists all job error categories.
+var ErrorCategories = []string{
+	ErrorInvalidRequest,
+	ErrorUnsupportedFormat,
+	ErrorQueueFull,
+	ErrorInsufficientResources,
+	ErrorConversionFailed,
+	ErrorTimeout,
+	ErrorCancelled,
+	ErrorInternal,
+	ErrorQuotaExceeded,
+	ErrorNotFound,
+}
+
+// ActivityErrorCategory returns the job error category of a failed activity. An activity that timed out
+// is a Timeout, even if the timeout carries the failure of an earlier attempt.
+func ActivityErrorCategory(err error) string {
+	var timeoutErr *temporal.TimeoutError
+	if errors.As(err, &timeoutErr) {
+		return ErrorTimeout
+	}
+	var appErr *temporal.ApplicationError
+	if errors.As(err, &appErr) && appErr.Type() != "" {
+		return appErr.Type()
+	}
+	return ErrorInternal
+}
@@ -0,0 +1,34 @@
+package models
+
+import (
+	"strconv"
+	"time"
+)
+
+// IdempotencyKey maps a client supplied key of a tenant to the job created for it.
+type IdempotencyKey struct {
+	Id        string    `json:"Id" bson:"_id"`              // Tenant and key, unique over all tenants
+	TenantId  string    `json:"TenantId" bson:"TenantId"`   // Organization that supplied the key
+	Key       string    `json:"Key" bson:"Key"`             // Key supplied with the CreateJob request
+	JobId     string    `json:"JobId" bson:"JobId"`         // Job created for the key
+	CreatedAt time.Time `json:"CreatedAt" bson:"CreatedAt"` // Time when the key was claimed
+	ExpireAt  time.Time `json:"ExpireAt" bson:"ExpireAt"`   // Time after which the key can be reused
+}
+
+// IdempotencyKeyId returns the ID of the key of the tenant. The tenant is prefixed with its length,
+// so the IDs of different tenants never collide, whatever characters the keys contain.
+func IdempotencyKeyId(tenantId string, key string) string {
+	return strconv.Itoa(len(tenantId)) + ":" + tenantId + "/" + key
+}
+
+// NewIdempotencyKey binds the key of the tenant to the job from now until the key expires.
+func NewIdempotencyKey(tenantId string, key string, jobId string, now time.Time, expire time.Duration) IdempotencyKey {
+	return IdempotencyKey{
+		Id:        IdempotencyKeyId(tenantId, key),
+		TenantId:  tenantId,
+		Key:       key,
+		JobId:     jobId,
+		CreatedAt: now,
+		ExpireAt:  now.Add(expire),
+	}
+}
@@ -0,0 +1,63 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.zixel.cn/go/framework"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestIdempotencyKeyId(t *testing.T) {
+	// The same ID for different tenants would hand out the jobs of another tenant
+	pairs := [][2]string{
+		{"a", "b/c"},
+		{"a/b", "c"},
+		{"a", "b"},
+		{"b", "a"},
+		{"", "a/b"},
+		{"1:a", "b"},
+	}
+	ids := make(map[string][2]string)
+	for _, pair := range pairs {
+		id := IdempotencyKeyId(pair[0], pair[1])
+		if other, ok := ids[id]; ok {
+			t.Errorf("IdempotencyKeyId(%q, %q) = IdempotencyKeyId(%q, %q) = %s", pair[0], pair[1], other[0], other[1], id)
+		}
+		ids[id] = pair
+	}
+}
+
+func TestNewIdempotencyKey(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	key := NewIdempotencyKey("tenant", "key", "job", now, time.Hour)
+
+	if key.Id != IdempotencyKeyId("tenant", "key") {
+		t.Errorf("Id = %s, want %s", key.Id, IdempotencyKeyId("tenant", "key"))
+	}
+	if key.TenantId != "tenant" || key.Key != "key" || key.JobId != "job" {
+		t.Errorf("key = %+v, want tenant, key and job", key)
+	}
+	if !key.CreatedAt.Equal(now) || !key.ExpireAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("key is valid from %v until %v, want %v until %v", key.CreatedAt, key.ExpireAt, now, now.Add(time.Hour))
+	}
+}
+
+func TestIdempotencyKeyIdOfCaller(t *testing.T) {
+	// The organization comes from the metadata of the call, gRPC sends its keys in lower case
+	ids := make(map[string]string)
+	for _, tenant := range []string{"tenant-a", "tenant-b"} {
+		var headers framework.CommonHeaders
+		if err := framework.DoTestRpcHeaders(metadata.Pairs("zixel-organization-id", tenant), &headers); err != nil {
+			t.Fatalf("DoTestRpcHeaders: %v", err)
+		}
+		if headers.TenantId != tenant {
+			t.Fatalf("TenantId = %q, want %q", headers.TenantId, tenant)
+		}
+		id := IdempotencyKeyId(headers.TenantId, "key")
+		if other, ok := ids[id]; ok {
+			t.Errorf("%s and %s share the idempotency key %s", other, tenant, id)
+		}
+		ids[id] = tenant
+	}
+}
@@ -0,0 +1,250 @@
+package models
+
+import "time"
+
+// Status values of a job record
+const (
+	JobStatusScheduled       = "Scheduled"       // Job is a recurring schedule, every run is created as a job of its own
+	JobStatusQueued          = "Queued"          // Job is stored and the workflow is waiting for a worker
+	JobStatusRunning         = "Running"         // Workflow is executing the job
+	JobStatusPaused          = "Paused"          // Workflow dispatches no further files until the job is resumed
+	JobStatusSucceeded       = "Succeeded"       // All files of the job were converted
+	JobStatusPartiallyFailed = "PartiallyFailed" // Some files of the job were converted, the others failed
+	JobStatusFailed          = "Failed"          // No file was converted or the workflow failed or could not be started
+	JobStatusCancelled       = "Cancelled"       // Job was cancelled before it finished
+)
+
+// Status values of a single file inside a job
+const (
+	FileStatusPending   = "Pending"   // File is waiting for or being converted
+	FileStatusSucceeded = "Succeeded" // File was converted
+	FileStatusFailed    = "Failed"    // File conversion failed
+	FileStatusCancelled = "Cancelled" // File conversion was cancelled with the job
+)
+
+// Priorities of a job, VIP jobs are dispatched to the priority task queue of their job type
+const (
+	PriorityNormal = "Normal" // Job is dispatched to the task queue of its job type
+	PriorityVIP    = "VIP"    // Job is dispatched to the priority task queue, workers poll it first
+)
+
+// Sources of the resource estimate of a job
+const (
+	EstimateSourceScript  = "ReScript" // Estimated by the ReScript of the job type
+	EstimateSourceHistory = "History"  // Averaged over the recently finished jobs of the job type
+)
+
+// JobProgressQuery is the name of the workflow query that returns the JobProgress of a running job.
+const JobProgressQuery = "JobProgress"
+
+// Signals of a running job workflow
+const (
+	JobPauseSignal  = "PauseJob"  // Workflow stops dispatching files, the files already dispatched finish
+	JobResumeSignal = "ResumeJob" // Workflow dispatches the remaining files again
+)
+
+// Job represents a conversion job submitted through CreateJob.
+type Job struct {
+	JobId           string         `json:"JobId" bson:"JobId"`                                       // Unique identifier for the job, also used as the workflow ID
+	TenantId        string         `json:"TenantId,omitempty" bson:"TenantId"`                       // Organization that submitted the job
+	AppId           string         `json:"AppId,omitempty" bson:"AppId"`                             // Application that submitted the job
+	UserId          string         `json:"UserId,omitempty" bson:"UserId"`                           // User that submitted the job
+	JobType         int32          `json:"JobType" bson:"JobType"`                                   // Type of the job requested by the client
+	StorageToken    string         `json:"StorageToken,omitempty" bson:"StorageToken"`               // Download and upload token passed to the workflow
+	Parameters      string         `json:"Parameters,omitempty" bson:"Parameters"`                   // Job parameters passed to the workflow
+	FixedParameters []string       `json:"FixedParameters,omitempty" bson:"FixedParameters"`         // Fixed parameters of the job type passed to the workflow
+	JeScript        string         `json:"JeScript,omitempty" bson:"JeScript,omitempty"`             // Entry command of the job type when the job was created
+	ScScript        string         `json:"ScScript,omitempty" bson:"ScScript,omitempty"`             // Progress script of the job type when the job was created
+	InputSize       int64          `json:"InputSize,omitempty" bson:"InputSize"`                     // Total size of the input files in bytes
+	SourceFormat    string         `json:"SourceFormat,omitempty" bson:"SourceFormat,omitempty"`     // Format of the input files, e.g. dwg
+	Resources       *Resources     `json:"Resources,omitempty" bson:"Resources,omitempty"`           // Resources the job is expected to consume, nil if they were not estimated
+	IdempotencyKey  string         `json:"IdempotencyKey,omitempty" bson:"IdempotencyKey,omitempty"` // Client supplied key the job was created with
+	TargetFormats   []string       `json:"TargetFormats,omitempty" bson:"TargetFormats,omitempty"`   // Formats the files are converted to
+	CallbackUrl     string         `json:"CallbackUrl,omitempty" bson:"CallbackUrl,omitempty"`       // URL notified of the status changes, replaces the webhook of the organization
+	RetryPolicy     *RetryPolicy   `json:"RetryPolicy,omitempty" bson:"RetryPolicy,omitempty"`       // Retry policy of the job type when the job was created
+	Timeouts        *Timeouts      `json:"Timeouts,omitempty" bson:"Timeouts,omitempty"`             // Timeouts of the job type when the job was created
+	RetryOf         string         `json:"RetryOf,omitempty" bson:"RetryOf,omitempty"`               // Job this job retries the failed files of
+	RetryFiles      []string       `json:"RetryFiles,omitempty" bson:"RetryFiles,omitempty"`         // Files to process when retrying, empty processes all files
+	TaskQueue       string         `json:"TaskQueue,omitempty" bson:"TaskQueue"`                     // Temporal task queue the workflow was started on
+	Priority        string         `json:"Priority,omitempty" bson:"Priority,omitempty"`             // Priority tier of the organization when the job was created
+	ResourcePoolId  string         `json:"ResourcePoolId,omitempty" bson:"ResourcePoolId"`           // Shared pool whose slots the job waits for, empty starts the job right away
+	Quotas          []string       `json:"-" bson:"Quotas,omitempty"`                                // Quotas a conversion was taken from for the job, refunded if it cannot be started
+	QueueReserved   bool           `json:"-" bson:"QueueReserved,omitempty"`                         // The job holds a place in the queue of its pool until it finishes
+	PoolSlots       int            `json:"-" bson:"PoolSlots,omitempty"`                             // Slots the dispatched job holds in the running count of its pool until it finishes or pauses
+	NotBefore       time.Time      `json:"NotBefore,omitempty" bson:"NotBefore"`                     // Time before which the workflow is not started, zero starts it right away
+	CronSchedule    string         `json:"CronSchedule,omitempty" bson:"CronSchedule,omitempty"`     // Cron expression of the Temporal schedule of a recurring job
+	ScheduleId      string         `json:"ScheduleId,omitempty" bson:"ScheduleId,omitempty"`         // Recurring job whose schedule started this run
+	NextRunAt       time.Time      `json:"NextRunAt,omitempty" bson:"NextRunAt,omitempty"`           // Time of the next run of a recurring job
+	Workflow        string         `json:"Workflow,omitempty" bson:"Workflow"`                       // Name of the workflow executing the job
+	RunId           string         `json:"RunId,omitempty" bson:"RunId"`                             // Temporal run ID of the workflow execution
+	Status          string         `json:"Status" bson:"Status"`                                     // Current status of the job
+	Progress        int32          `json:"Progress" bson:"Progress"`                                 // Progress of the job in percent
+	Message         string         `json:"Message,omitempty" bson:"Message"`                         // Last message reported for the job
+	ErrorCategory   string         `json:"ErrorCategory,omitempty" bson:"ErrorCategory,omitempty"`   // Category of the failure, see the Error constants
+	Files           []FileProgress `json:"Files,omitempty" bson:"Files"`                             // Progress of each file of the job
+	CreatedAt       time.Time      `json:"CreatedAt" bson:"CreatedAt"`                               // Time when the job was created
+	UpdatedAt       time.Time      `json:"UpdatedAt" bson:"UpdatedAt"`                               // Time when the job was last updated
+	DispatchedAt    time.Time      `json:"DispatchedAt,omitempty" bson:"DispatchedAt"`               // Time when the workflow of the job was started
+	StartedAt       time.Time      `json:"StartedAt,omitempty" bson:"StartedAt"`                     // Time when the first file of the job started converting
+	FinishedAt      time.Time      `json:"FinishedAt,omitempty" bson:"FinishedAt"`                   // Time when the job reached a finished status
+	Outbox          []OutboxEvent  `json:"-" bson:"Outbox,omitempty"`                                // Events of the job not yet published on the message bus
+	OutboxLeaseEnd  time.Time      `json:"-" bson:"OutboxLeaseEnd,omitempty"`                        // Time until when a server instance publishes the outbox
+}
+
+// Resources are the resources a job is expected to consume, zero values were not estimated.
+// Estimates from the history only predict the duration.
+type Resources struct {
+	CpuCores        float64 `json:"CpuCores,omitempty" bson:"CpuCores"`               // CPU cores
+	MemoryMB        int64   `json:"MemoryMB,omitempty" bson:"MemoryMB"`               // Memory in megabytes
+	DurationSeconds int64   `json:"DurationSeconds,omitempty" bson:"DurationSeconds"` // Conversion time in seconds
+	Source          string  `json:"Source" bson:"Source"`                             // How the resources were estimated, see the EstimateSource constants
+}
+
+// FileProgress represents the conversion progress of a single file of a job.
+type FileProgress struct {
+	File          string   `json:"File" bson:"File"`                                       // Name of the file
+	Status        string   `json:"Status" bson:"Status"`                                   // Status of the file conversion
+	Progress      int32    `json:"Progress" bson:"Progress"`                               // Progress of the file conversion in percent
+	Message       string   `json:"Message,omitempty" bson:"Message"`                       // Message from the file conversion
+	ErrorCategory string   `json:"ErrorCategory,omitempty" bson:"ErrorCategory,omitempty"` // Category of the failure of the file
+	Outputs       []string `json:"Outputs,omitempty" bson:"Outputs,omitempty"`             // Files written by the conversion
+	DurationMs    int64    `json:"DurationMs,omitempty" bson:"DurationMs,omitempty"`       // Time the file took to finish in milliseconds
+}
+
+// JobResultStatus returns the overall status of a completed job from the results of its files.
+// A job that reported no files converted nothing and failed.
+func JobResultStatus(files []FileProgress) string {
+	if len(files) == 0 {
+		return JobStatusFailed
+	}
+
+	succeeded := 0
+	for _, file := range files {
+		if file.Status == FileStatusSucceeded {
+			succeeded++
+		}
+	}
+
+	switch {
+	case succeeded == len(files):
+		return JobStatusSucceeded
+	case succeeded == 0:
+		return JobStatusFailed
+	default:
+		return JobStatusPartiallyFailed
+	}
+}
+
+// RetryFiles returns the files a retry of the finished job reruns, all files that were not converted.
+// It returns nil for a job that failed before reporting its files, the retry reruns all of them.
+func RetryFiles(files []FileProgress) []string {
+	var retry []string
+	for _, file := range files {
+		if file.Status != FileStatusSucceeded {
+			retry = append(retry, file.File)
+		}
+	}
+	return retry
+}
+
+// WorkflowStarted returns true if the workflow of the job is running at the given time. The workflow
+// of a job of a shared pool starts when the dispatcher hands it a slot, a delayed workflow at NotBefore.
+func (j *Job) WorkflowStarted(now time.Time) bool {
+	return (j.ResourcePoolId == "" || !j.DispatchedAt.IsZero()) && !now.Before(j.NotBefore)
+}
+
+// CheckPause returns why the job cannot be paused at the given time, or an empty string if it can.
+// Only queued and running jobs whose workflow started can be paused, a signal would start a delayed workflow right away.
+func (j *Job) CheckPause(now time.Time) string {
+	switch {
+	case j.Status != JobStatusQueued && j.Status != JobStatusRunning:
+		return "Job is " + j.Status + ", only queued or running Jobs can be paused"
+	case !j.WorkflowStarted(now):
+		return "Job has not started yet"
+	}
+	return ""
+}
+
+// CheckResume returns why the job cannot be resumed, or an empty string if it can.
+func (j *Job) CheckResume() string {
+	if j.Status != JobStatusPaused {
+		return "Job is " + j.Status + ", only paused Jobs can be resumed"
+	}
+	return ""
+}
+
+// WorkflowOptions are passed to the workflow of a job in addition to its parameters.
+type WorkflowOptions struct {
+	RetryPolicy *RetryPolicy `json:"RetryPolicy,omitempty"` // Retry policy of the activities, nil uses the Temporal defaults
+	Files       []string     `json:"Files,omitempty"`       // Only these files of the parameters are processed, empty processes all files
+	JeScript    string       `json:"JeScript,omitempty"`    // Entry command of the job type, run by the script worker
+	ScScript    string       `json:"ScScript,omitempty"`    // Progress script of the job type, applied to the output of the entry command
+	Timeouts    *Timeouts    `json:"Timeouts,omitempty"`    // Timeouts of the activities, nil uses the defaults of the workflow
+	InputSize   int64        `json:"InputSize,omitempty"`   // Total size of the input files in bytes, scales the timeouts
+}
+
+// FileProgressUpdate is the progress of a file an activity converts. Activities record it as first
+// detail of their heartbeats, the server reads it from the pending activities of the workflow.
+type FileProgressUpdate struct {
+	File     string `json:"File"`              // Name of the file
+	Progress int32  `json:"Progress"`          // Progress of the file conversion in percent
+	Message  string `json:"Message,omitempty"` // Message from the file conversion
+}
+
+// JobProgress is reported by a running workflow through the JobProgressQuery.
+type JobProgress struct {
+	Status        string         `json:"Status"`                  // Status of the job as seen by the workflow
+	Progress      int32          `json:"Progress"`                // Overall progress of the job in percent
+	Message       string         `json:"Message,omitempty"`       // Message from the workflow
+	ErrorCategory string         `json:"ErrorCategory,omitempty"` // Category of the first failure reported by the workflow
+	Files         []FileProgress `json:"Files,omitempty"`         // Progress of each file of the job
+}
+
+// ApplyFileProgress applies the progress of a file to the first pending file of the name and updates
+// the progress of the job. Updates may arrive late or twice, the progress of a file never decreases.
+func (p *JobProgress) ApplyFileProgress(update FileProgressUpdate) {
+	for i := range p.Files {
+		file := &p.Files[i]
+		if file.File != update.File || file.Status != FileStatusPending {
+			continue
+		}
+		if update.Progress > file.Progress {
+			file.Progress = update.Progress
+		}
+		if update.Message != "" {
+			file.Message = update.Message
+		}
+		p.Progress = FilesProgress(p.Files)
+		return
+	}
+}
+
+// FilesProgress returns the progress of a job, the average progress of its files.
+// Finished files count as complete whether they succeeded or not.
+func FilesProgress(files []FileProgress) int32 {
+	if len(files) == 0 {
+		return 0
+	}
+	var total int64
+	for _, file := range files {
+		if file.Status == FileStatusPending {
+			total += int64(file.Progress)
+		} else {
+			total += 100
+		}
+	}
+	return int32(total / int64(len(files)))
+}
+
+// JobEvent records a status or progress change of a job, watchers resume after the last Seq they received.
+type JobEvent struct {
+	Seq           int64          `json:"Seq" bson:"Seq"`                                         // Sequence number, increases over all jobs
+	JobId         string         `json:"JobId" bson:"JobId"`                                     // Identifier of the job
+	TenantId      string         `json:"TenantId" bson:"TenantId"`                               // Organization the job belongs to
+	Status        string         `json:"Status" bson:"Status"`                                   // Status of the job after the change
+	Progress      int32          `json:"Progress" bson:"Progress"`                               // Progress of the job in percent
+	Message       string         `json:"Message,omitempty" bson:"Message"`                       // Message of the job
+	ErrorCategory string         `json:"ErrorCategory,omitempty" bson:"ErrorCategory,omitempty"` // Category of the failure of the job
+	Files         []FileProgress `json:"Files,omitempty" bson:"Files"`                           // Progress of each file of the job
+	CreatedAt     time.Time      `json:"CreatedAt" bson:"CreatedAt"`                             // Time of the change
+}
@@ -0,0 +1,114 @@
+package models
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJobResultStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		files  []string
+		status string
+	}{
+		{"no files", nil, JobStatusFailed},
+		{"all succeeded", []string{FileStatusSucceeded, FileStatusSucceeded}, JobStatusSucceeded},
+		{"one failed", []string{FileStatusSucceeded, FileStatusFailed}, JobStatusPartiallyFailed},
+		{"one cancelled", []string{FileStatusCancelled, FileStatusSucceeded}, JobStatusPartiallyFailed},
+		{"all failed", []string{FileStatusFailed, FileStatusCancelled}, JobStatusFailed},
+		{"pending", []string{FileStatusPending}, JobStatusFailed},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var files []FileProgress
+			for i, status := range test.files {
+				files = append(files, FileProgress{File: string(rune('a' + i)), Status: status})
+			}
+			if status := JobResultStatus(files); status != test.status {
+				t.Errorf("JobResultStatus(%v) = %s, want %s", test.files, status, test.status)
+			}
+		})
+	}
+}
+
+func TestRetryFiles(t *testing.T) {
+	files := []FileProgress{
+		{File: "a.dwg", Status: FileStatusSucceeded},
+		{File: "b.dwg", Status: FileStatusFailed},
+		{File: "c.dwg", Status: FileStatusCancelled},
+		{File: "d.dwg", Status: FileStatusPending},
+	}
+	if got, want := RetryFiles(files), []string{"b.dwg", "c.dwg", "d.dwg"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("RetryFiles = %v, want %v", got, want)
+	}
+	if got := RetryFiles(files[:1]); got != nil {
+		t.Errorf("RetryFiles of a succeeded job = %v, want nil", got)
+	}
+	if got := RetryFiles(nil); got != nil {
+		t.Errorf("RetryFiles without files = %v, want nil", got)
+	}
+}
+
+func TestPauseResume(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		job     Job
+		started bool
+		pause   bool
+		resume  bool
+	}{
+		{"running", Job{Status: JobStatusRunning, DispatchedAt: now}, true, true, false},
+		{"queued", Job{Status: JobStatusQueued, DispatchedAt: now}, true, true, false},
+		{"waiting for the pool", Job{Status: JobStatusQueued, ResourcePoolId: "pool"}, false, false, false},
+		{"dispatched by the pool", Job{Status: JobStatusQueued, ResourcePoolId: "pool", DispatchedAt: now}, true, true, false},
+		{"delayed", Job{Status: JobStatusQueued, DispatchedAt: now, NotBefore: now.Add(time.Minute)}, false, false, false},
+		{"delay passed", Job{Status: JobStatusQueued, DispatchedAt: now, NotBefore: now}, true, true, false},
+		{"paused", Job{Status: JobStatusPaused, DispatchedAt: now}, true, false, true},
+		{"finished", Job{Status: JobStatusSucceeded, DispatchedAt: now}, true, false, false},
+		{"scheduled", Job{Status: JobStatusScheduled}, true, false, false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if started := test.job.WorkflowStarted(now); started != test.started {
+				t.Errorf("WorkflowStarted = %v, want %v", started, test.started)
+			}
+			if reason := test.job.CheckPause(now); (reason == "") != test.pause {
+				t.Errorf("CheckPause = %q, want pausable %v", reason, test.pause)
+			}
+			if reason := test.job.CheckResume(); (reason == "") != test.resume {
+				t.Errorf("CheckResume = %q, want resumable %v", reason, test.resume)
+			}
+		})
+	}
+}
+
+func TestApplyFileProgress(t *testing.T) {
+	progress := JobProgress{Files: []FileProgress{
+		{File: "a.dwg", Status: FileStatusSucceeded},
+		{File: "b.dwg", Status: FileStatusPending, Progress: 40},
+	}}
+
+	steps := []struct {
+		update   FileProgressUpdate
+		file     int32
+		progress int32
+	}{
+		{FileProgressUpdate{File: "b.dwg", Progress: 60, Message: "loading"}, 60, 80},
+		{FileProgressUpdate{File: "b.dwg", Progress: 50}, 60, 80}, // A late update does not decrease the progress
+		{FileProgressUpdate{File: "c.dwg", Progress: 90}, 60, 80}, // Unknown files are ignored
+		{FileProgressUpdate{File: "a.dwg", Progress: 10}, 60, 80}, // Finished files keep their result
+	}
+	for _, step := range steps {
+		progress.ApplyFileProgress(step.update)
+		if progress.Files[1].Progress != step.file || progress.Progress != step.progress {
+			t.Errorf("after %+v: file %d%%, job %d%%; want %d%%, %d%%", step.update, progress.Files[1].Progress, progress.Progress, step.file, step.progress)
+		}
+	}
+	if progress.Files[1].Message != "loading" || progress.Files[0].Progress != 0 {
+		t.Errorf("files = %+v", progress.Files)
+	}
+}
@@ -1,14 +1,149 @@
 package models
 
+import (
+	"math"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
 // JobType struct for different JobTypes
 type JobType struct {
-	JobTypeId           string   `json:"JobTypeId" bson:"JobTypeId"`                               //Unique Identifier for the JobType
-	SystemSpecification int32    `json:"SystemSpecification,omitempty" bson:"SystemSpecification"` // 1 for POD, 2 for ECS
-	ImageUrl            string   `json:"ImageUrl,omitempty" bson:"ImageUrl"`                       // Docker image URL for POD, system image for ECS
-	ReScript            string   `json:"ReScript,omitempty" bson:"ReScript"`                       // Used to estimate the resources consumed by the task
-	ScScript            string   `json:"ScScript,omitempty" bson:"ScScript"`                       // Used to collect task status and progress from the output of the command line
-	JeScript            string   `json:"JeScript,omitempty" bson:"JeScript"`                       // Task entry command
-	FixedParameters     []string `json:"FixedParameters,omitempty" bson:"FixedParameters"`         // Relevant parameters for the task
+	JobTypeId           string       `json:"JobTypeId" bson:"JobTypeId"`                               //Unique Identifier for the JobType
+	SystemSpecification int32        `json:"SystemSpecification,omitempty" bson:"SystemSpecification"` // 1 for POD, 2 for ECS
+	ImageUrl            string       `json:"ImageUrl,omitempty" bson:"ImageUrl"`                       // Docker image URL for POD, system image for ECS
+	ReScript            string       `json:"ReScript,omitempty" bson:"ReScript"`                       // Used to estimate the resources consumed by the task
+	ScScript            string       `json:"ScScript,omitempty" bson:"ScScript"`                       // Used to collect task status and progress from the output of the command line
+	JeScript            string       `json:"JeScript,omitempty" bson:"JeScript"`                       // Task entry command
+	FixedParameters     []string     `json:"FixedParameters,omitempty" bson:"FixedParameters"`         // Relevant parameters for the task
+	TaskQueue           string       `json:"TaskQueue,omitempty" bson:"TaskQueue"`                     // Temporal task queue the jobs of this type are dispatched to
+	Workflow            string       `json:"Workflow,omitempty" bson:"Workflow"`                       // Name of the workflow executing the jobs of this type
+	RetryPolicy         *RetryPolicy `json:"RetryPolicy,omitempty" bson:"RetryPolicy,omitempty"`       // Retries of the activities of the jobs, nil uses the Temporal defaults
+	Timeouts            *Timeouts    `json:"Timeouts,omitempty" bson:"Timeouts,omitempty"`             // Timeouts of the jobs and their activities, nil uses the defaults of the workflow
+}
+
+// RetryPolicy controls how often and when the activities of a job are retried.
+type RetryPolicy struct {
+	MaxAttempts         int32    `json:"MaxAttempts,omitempty" bson:"MaxAttempts"`                 // Maximum number of attempts, 0 is unlimited
+	InitialIntervalMs   int64    `json:"InitialIntervalMs,omitempty" bson:"InitialIntervalMs"`     // Backoff before the first retry in milliseconds
+	BackoffCoefficient  float64  `json:"BackoffCoefficient,omitempty" bson:"BackoffCoefficient"`   // Factor the backoff grows with on every retry
+	MaximumIntervalMs   int64    `json:"MaximumIntervalMs,omitempty" bson:"MaximumIntervalMs"`     // Upper bound of the backoff in milliseconds
+	RetryableCategories []string `json:"RetryableCategories,omitempty" bson:"RetryableCategories"` // Error categories that are retried, empty retries all
+}
+
+// TemporalPolicy converts the policy to the retry policy of the activities.
+// Categories that are not retryable are passed as non retryable error types.
+func (p *RetryPolicy) TemporalPolicy() *temporal.RetryPolicy {
+	if p == nil {
+		return nil
+	}
+
+	policy := &temporal.RetryPolicy{
+		InitialInterval:    time.Duration(p.InitialIntervalMs) * time.Millisecond,
+		BackoffCoefficient: p.BackoffCoefficient,
+		MaximumInterval:    time.Duration(p.MaximumIntervalMs) * time.Millisecond,
+		MaximumAttempts:    p.MaxAttempts,
+	}
+
+	if len(p.RetryableCategories) > 0 {
+		retryable := make(map[string]bool)
+		for _, category := range p.RetryableCategories {
+			retryable[category] = true
+		}
+		for _, category := range ErrorCategories {
+			if !retryable[category] {
+				policy.NonRetryableErrorTypes = append(policy.NonRetryableErrorTypes, category)
+			}
+		}
+	}
+
+	return policy
+}
+
+// Timeouts bound the jobs of a job type and their activities, zero values keep the defaults of the workflow.
+// The activity deadlines and the job timeout grow by SecondsPerMB for every MB of the input of a job,
+// so larger inputs get proportionally longer deadlines.
+type Timeouts struct {
+	ScheduleToStartSeconds int64   `json:"ScheduleToStartSeconds,omitempty" bson:"ScheduleToStartSeconds"` // Time a file may wait for a worker
+	StartToCloseSeconds    int64   `json:"StartToCloseSeconds,omitempty" bson:"StartToCloseSeconds"`       // Time an attempt of a file may take
+	ScheduleToCloseSeconds int64   `json:"ScheduleToCloseSeconds,omitempty" bson:"ScheduleToCloseSeconds"` // Time a file may take including its retries
+	HeartbeatSeconds       int64   `json:"HeartbeatSeconds,omitempty" bson:"HeartbeatSeconds"`             // Time an attempt may run without heartbeat
+	JobSeconds             int64   `json:"JobSeconds,omitempty" bson:"JobSeconds"`                         // Time the whole job may take, 0 uses the job_timeout of the service
+	SecondsPerMB           float64 `json:"SecondsPerMB,omitempty" bson:"SecondsPerMB"`                     // Seconds added to the deadlines per MB of input
+}
+
+// MaxTimeout is the longest deadline, longer deadlines are clamped so they fit a time.Duration.
+const MaxTimeout = 100 * 365 * 24 * time.Hour // About 100 years
+
+// timeout converts the seconds to a deadline clamped to MaxTimeout.
+func timeout(seconds float64) time.Duration {
+	return time.Duration(math.Min(seconds, MaxTimeout.Seconds()) * float64(time.Second))
+}
+
+// extendTimeout adds the allowance to the deadline d, the sum is clamped to MaxTimeout.
+func extendTimeout(d time.Duration, allowance time.Duration) time.Duration {
+	if d > MaxTimeout-allowance {
+		return MaxTimeout
+	}
+	return d + allowance
+}
+
+// sizeAllowance returns the time added to the deadlines of a job with the given input size,
+// at most MaxTimeout.
+func (t *Timeouts) sizeAllowance(inputSize int64) time.Duration {
+	if t.SecondsPerMB <= 0 || inputSize <= 0 {
+		return 0
+	}
+	return timeout(float64(inputSize) / (1 << 20) * t.SecondsPerMB)
+}
+
+// ApplyTo sets the timeouts on the activity options of a job with the given input size. Timeouts that
+// are not declared keep the values of the options, unlimited deadlines stay unlimited. Deadlines are
+// clamped to MaxTimeout.
+func (t *Timeouts) ApplyTo(options *workflow.ActivityOptions, inputSize int64) {
+	if t == nil {
+		return
+	}
+
+	if t.ScheduleToStartSeconds > 0 {
+		options.ScheduleToStartTimeout = timeout(float64(t.ScheduleToStartSeconds))
+	}
+	if t.StartToCloseSeconds > 0 {
+		options.StartToCloseTimeout = timeout(float64(t.StartToCloseSeconds))
+	}
+	if t.ScheduleToCloseSeconds > 0 {
+		options.ScheduleToCloseTimeout = timeout(float64(t.ScheduleToCloseSeconds))
+	}
+	if t.HeartbeatSeconds > 0 {
+		options.HeartbeatTimeout = timeout(float64(t.HeartbeatSeconds))
+	}
+
+	allowance := t.sizeAllowance(inputSize)
+	if options.StartToCloseTimeout > 0 {
+		options.StartToCloseTimeout = extendTimeout(options.StartToCloseTimeout, allowance)
+	}
+	if options.ScheduleToCloseTimeout > 0 {
+		options.ScheduleToCloseTimeout = extendTimeout(options.ScheduleToCloseTimeout, allowance)
+	}
+}
+
+// JobTimeout returns the time a job with the given input size may take, defaultSeconds applies if
+// the job type declares no job timeout. It returns 0 if the job is not limited, the timeout is
+// clamped to MaxTimeout.
+func (t *Timeouts) JobTimeout(defaultSeconds int64, inputSize int64) time.Duration {
+	if t == nil {
+		return timeout(float64(defaultSeconds))
+	}
+
+	seconds := t.JobSeconds
+	if seconds <= 0 {
+		seconds = defaultSeconds
+	}
+	if seconds <= 0 {
+		return 0
+	}
+	return extendTimeout(timeout(float64(seconds)), t.sizeAllowance(inputSize))
 }
 
 // Job Type Filter for Different Database Queries
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestTimeoutsApplyTo(t *testing.T) {
+	defaults := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Hour,
+		HeartbeatTimeout:       10 * time.Second,
+	}
+
+	tests := []struct {
+		name      string
+		timeouts  *Timeouts
+		inputSize int64
+		want      workflow.ActivityOptions
+	}{
+		{"nil keeps the defaults", nil, 100 << 20, defaults},
+		{"declared", &Timeouts{ScheduleToStartSeconds: 30, StartToCloseSeconds: 600, ScheduleToCloseSeconds: 1800, HeartbeatSeconds: 20}, 0,
+			workflow.ActivityOptions{ScheduleToStartTimeout: 30 * time.Second, StartToCloseTimeout: 600 * time.Second, ScheduleToCloseTimeout: 1800 * time.Second, HeartbeatTimeout: 20 * time.Second}},
+		{"scaled by the input", &Timeouts{StartToCloseSeconds: 600, ScheduleToCloseSeconds: 1800, SecondsPerMB: 0.5}, 100 << 20,
+			workflow.ActivityOptions{ScheduleToStartTimeout: time.Minute, StartToCloseTimeout: 650 * time.Second, ScheduleToCloseTimeout: 1850 * time.Second, HeartbeatTimeout: 10 * time.Second}},
+		{"scales the defaults", &Timeouts{SecondsPerMB: 2}, 30 << 20,
+			workflow.ActivityOptions{ScheduleToStartTimeout: time.Minute, StartToCloseTimeout: time.Hour + time.Minute, HeartbeatTimeout: 10 * time.Second}},
+		{"no input", &Timeouts{SecondsPerMB: 2}, 0, defaults},
+		// The allowance of a huge input would overflow a time.Duration
+		{"huge input", &Timeouts{ScheduleToCloseSeconds: 1800, SecondsPerMB: 1e6}, math.MaxInt64,
+			workflow.ActivityOptions{ScheduleToStartTimeout: time.Minute, StartToCloseTimeout: MaxTimeout, ScheduleToCloseTimeout: MaxTimeout, HeartbeatTimeout: 10 * time.Second}},
+		{"huge declared", &Timeouts{StartToCloseSeconds: math.MaxInt64}, 0,
+			workflow.ActivityOptions{ScheduleToStartTimeout: time.Minute, StartToCloseTimeout: MaxTimeout, HeartbeatTimeout: 10 * time.Second}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			options := defaults
+			test.timeouts.ApplyTo(&options, test.inputSize)
+			if options != test.want {
+				t.Errorf("ApplyTo = %+v, want %+v", options, test.want)
+			}
+		})
+	}
+}
+
+func TestTimeoutsJobTimeout(t *testing.T) {
+	tests := []struct {
+		name           string
+		timeouts       *Timeouts
+		defaultSeconds int64
+		inputSize      int64
+		want           time.Duration
+	}{
+		{"nil uses the default", nil, 3600, 100 << 20, time.Hour},
+		{"nil without default", nil, 0, 100 << 20, 0},
+		{"declared", &Timeouts{JobSeconds: 600}, 3600, 0, 10 * time.Minute},
+		{"default", &Timeouts{SecondsPerMB: 1}, 3600, 60 << 20, time.Hour + time.Minute},
+		{"scaled", &Timeouts{JobSeconds: 600, SecondsPerMB: 0.1}, 3600, 1000 << 20, 700 * time.Second},
+		{"unlimited", &Timeouts{SecondsPerMB: 1}, 0, 60 << 20, 0},
+		{"huge input", &Timeouts{JobSeconds: 600, SecondsPerMB: 1e6}, 3600, math.MaxInt64, MaxTimeout},
+		{"huge declared", &Timeouts{JobSeconds: math.MaxInt64}, 3600, 0, MaxTimeout},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.timeouts.JobTimeout(test.defaultSeconds, test.inputSize); got != test.want {
+				t.Errorf("JobTimeout = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
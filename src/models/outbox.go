@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+// Types of the job events published on the progress topic of the message bus
+const (
+	OutboxEventCreated   = "job.created"   // Job was stored and queued
+	OutboxEventProgress  = "job.progress"  // Status or progress of an unfinished job changed
+	OutboxEventCompleted = "job.completed" // Job finished with converted files
+	OutboxEventFailed    = "job.failed"    // Job failed or was cancelled
+)
+
+// OutboxEvent is a job event kept in the outbox of its job until it is published on the message bus.
+// It is written together with the change of the job, so no event is lost when the broker is down.
+type OutboxEvent struct {
+	EventId       string    `json:"eventId" bson:"EventId"`                                 // Unique identifier for the event, consumers drop duplicates with it
+	Type          string    `json:"type" bson:"Type"`                                       // Type of the event
+	JobId         string    `json:"jobId" bson:"JobId"`                                     // Identifier of the job
+	TenantId      string    `json:"tenantId,omitempty" bson:"TenantId"`                     // Organization of the job
+	AppId         string    `json:"appId,omitempty" bson:"AppId"`                           // Application that submitted the job
+	UserId        string    `json:"userId,omitempty" bson:"UserId"`                         // User that submitted the job
+	JobType       int32     `json:"jobType" bson:"JobType"`                                 // Type of the job
+	Status        string    `json:"status" bson:"Status"`                                   // Status of the job
+	Progress      int32     `json:"progress" bson:"Progress"`                               // Progress of the job in percent
+	Message       string    `json:"message,omitempty" bson:"Message,omitempty"`             // Message from the job
+	ErrorCategory string    `json:"errorCategory,omitempty" bson:"ErrorCategory,omitempty"` // Category of the failure of the job
+	OccurredAt    time.Time `json:"occurredAt" bson:"OccurredAt"`                           // Time of the change
+}
+
+// OutboxEventType returns the type of the event published when the job reaches the status.
+func OutboxEventType(status string) string {
+	switch status {
+	case JobStatusSucceeded, JobStatusPartiallyFailed:
+		return OutboxEventCompleted
+	case JobStatusFailed, JobStatusCancelled:
+		return OutboxEventFailed
+	default:
+		return OutboxEventProgress
+	}
+}
@@ -0,0 +1,68 @@
+package models
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// QuotaUnlimited as AvailableConversions allows any number of conversions.
+const QuotaUnlimited = -1
+
+// Quota limits the conversions of an organization or a user, it replaces the Equity checks of v1.
+type Quota struct {
+	QuotaId              string    `json:"QuotaId" bson:"QuotaId"`                                 // Unique identifier for the quota
+	TenantId             string    `json:"TenantId,omitempty" bson:"TenantId"`                     // Organization the quota applies to, empty applies to the user in every organization
+	UserId               string    `json:"UserId,omitempty" bson:"UserId"`                         // User the quota applies to, empty applies to all users of the organization
+	AvailableConversions int64     `json:"AvailableConversions" bson:"AvailableConversions"`       // Jobs that can still be created, QuotaUnlimited allows any number
+	UsedConversions      int64     `json:"UsedConversions" bson:"UsedConversions"`                 // Jobs created with the quota
+	ValidFrom            time.Time `json:"ValidFrom,omitempty" bson:"ValidFrom"`                   // Time from when the quota is valid, zero is valid right away
+	ValidUntil           time.Time `json:"ValidUntil,omitempty" bson:"ValidUntil"`                 // Time until when the quota is valid, zero never expires
+	JobTypes             []int32   `json:"JobTypes,omitempty" bson:"JobTypes,omitempty"`           // Allowed job types, empty allows all types
+	TargetFormats        []string  `json:"TargetFormats,omitempty" bson:"TargetFormats,omitempty"` // Allowed target formats, empty allows all formats
+	IsEnable             bool      `json:"IsEnable" bson:"IsEnable"`                               // Disabled quotas are ignored
+	CreatedAt            time.Time `json:"CreatedAt" bson:"CreatedAt"`                             // Time when the quota was created
+	UpdatedAt            time.Time `json:"UpdatedAt" bson:"UpdatedAt"`                             // Time when the quota was last updated
+}
+
+// ValidAt returns true if the quota is valid at the given time, quotas outside their validity do not apply.
+func (q *Quota) ValidAt(now time.Time) bool {
+	return (q.ValidFrom.IsZero() || !now.Before(q.ValidFrom)) && (q.ValidUntil.IsZero() || now.Before(q.ValidUntil))
+}
+
+// Check returns why the quota does not allow a job of the job type converting to the target formats,
+// or an empty string if it does. A quota restricting the formats does not allow a job without target
+// formats. Neither the validity nor the available conversions are checked.
+func (q *Quota) Check(jobType int32, targetFormats []string) string {
+	if len(q.JobTypes) > 0 {
+		allowed := false
+		for _, t := range q.JobTypes {
+			allowed = allowed || t == jobType
+		}
+		if !allowed {
+			return fmt.Sprintf("Quota %s does not allow Job Type %d", q.QuotaId, jobType)
+		}
+	}
+
+	if len(q.TargetFormats) > 0 {
+		if len(targetFormats) == 0 {
+			return fmt.Sprintf("Quota %s only allows the target formats %s", q.QuotaId, strings.Join(q.TargetFormats, ", "))
+		}
+		allowed := make(map[string]bool)
+		for _, format := range q.TargetFormats {
+			allowed[NormalizeFormat(format)] = true
+		}
+		for _, format := range targetFormats {
+			if !allowed[NormalizeFormat(format)] {
+				return fmt.Sprintf("Quota %s does not allow the target format %s", q.QuotaId, format)
+			}
+		}
+	}
+
+	return ""
+}
+
+// NormalizeFormat returns the file format in lower case without a leading dot, e.g. "dwg" for ".DWG".
+func NormalizeFormat(format string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(format), "."))
+}
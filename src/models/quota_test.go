@@ -0,0 +1,58 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestQuotaValidAt(t *testing.T) {
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		quota Quota
+		valid bool
+	}{
+		{"always", Quota{}, true},
+		{"started", Quota{ValidFrom: now}, true},
+		{"not yet", Quota{ValidFrom: now.Add(time.Hour)}, false},
+		{"expires later", Quota{ValidUntil: now.Add(time.Hour)}, true},
+		{"expired", Quota{ValidUntil: now}, false},
+		{"window", Quota{ValidFrom: now.Add(-time.Hour), ValidUntil: now.Add(time.Hour)}, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if valid := test.quota.ValidAt(now); valid != test.valid {
+				t.Errorf("ValidAt = %v, want %v", valid, test.valid)
+			}
+		})
+	}
+}
+
+func TestQuotaCheck(t *testing.T) {
+	tests := []struct {
+		name          string
+		quota         Quota
+		jobType       int32
+		targetFormats []string
+		allowed       bool
+	}{
+		{"unrestricted", Quota{}, 1, nil, true},
+		{"allowed job type", Quota{JobTypes: []int32{1, 2}}, 2, nil, true},
+		{"other job type", Quota{JobTypes: []int32{1, 2}}, 3, nil, false},
+		{"allowed formats", Quota{TargetFormats: []string{"pdf", ".SVG"}}, 1, []string{".PDF", "svg"}, true},
+		{"other format", Quota{TargetFormats: []string{"pdf"}}, 1, []string{"pdf", "png"}, false},
+		{"no formats", Quota{TargetFormats: []string{"pdf"}}, 1, nil, false},
+		{"expired", Quota{ValidUntil: time.Unix(1, 0)}, 1, nil, true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			quota := test.quota
+			quota.QuotaId = "q"
+			if reason := quota.Check(test.jobType, test.targetFormats); (reason == "") != test.allowed {
+				t.Errorf("Check(%d, %v) = %q, want allowed %v", test.jobType, test.targetFormats, reason, test.allowed)
+			}
+		})
+	}
+}
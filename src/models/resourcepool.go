@@ -1,5 +1,10 @@
 package models
 
+import (
+	"math"
+	"strconv"
+)
+
 // ResourcePool represents a pool of resources with associated configuration.
 type ResourcePool struct {
 	ResourcePoolID  string                          `json:"ResourcePoolID" bson:"ResourcePoolId"`             // Unique identifier for the resource pool
@@ -12,6 +17,63 @@ type ResourcePool struct {
 	Fixed           int32                           `json:"Fixed,omitempty" bson:"Fixed"`                     // Fixed value associated with the resource pool
 	DefaultTaskSet  int32                           `json:"DefaultTaskSet,omitempty" bson:"DefaultTaskSet"`   // Default task set for the resource pool
 	ResourceLimits  map[string]*ResourceLimitOfTask `json:"ResourceLimits,omitempty" bson:"ResourceLimits"`   // List of resource limits associated with tasks
+	SlotCpu         float64                         `json:"SlotCpu,omitempty" bson:"SlotCpu"`                 // CPU cores of a slot, 0 does not limit the CPU of the jobs
+	SlotMemoryMB    int64                           `json:"SlotMemoryMB,omitempty" bson:"SlotMemoryMB"`       // Memory of a slot in megabytes, 0 does not limit the memory of the jobs
+}
+
+// maxJobSlots bounds the slots of a job, so a huge estimate cannot overflow the count.
+const maxJobSlots = math.MaxInt32
+
+// JobSlots returns the number of slots a job with the estimated resources takes in the pool.
+// A job takes as many slots as its CPU and memory need, at least one and at most maxJobSlots.
+func (p *ResourcePool) JobSlots(resources *Resources) int {
+	slots := 1
+	if resources == nil {
+		return slots
+	}
+	if p.SlotCpu > 0 {
+		if n := math.Ceil(resources.CpuCores / p.SlotCpu); n > float64(slots) {
+			slots = int(math.Min(n, maxJobSlots))
+		}
+	}
+	if p.SlotMemoryMB > 0 {
+		n := resources.MemoryMB / p.SlotMemoryMB
+		if resources.MemoryMB%p.SlotMemoryMB > 0 {
+			n++
+		}
+		if n > maxJobSlots {
+			n = maxJobSlots
+		}
+		if int(n) > slots {
+			slots = int(n)
+		}
+	}
+	return slots
+}
+
+// JobTypeSlots returns the number of slots of the pool that jobs of the job type may use,
+// -1 if the pool does not limit the job type. A share of the pool always grants at least one slot.
+func (p *ResourcePool) JobTypeSlots(jobType int32) int {
+	limit, ok := p.ResourceLimits[strconv.Itoa(int(jobType))]
+	if !ok || limit == nil || limit.ScalingLimit <= 0 || p.ScalingLimit <= 0 {
+		return -1
+	}
+
+	slots := int(p.ScalingLimit) * int(limit.ScalingLimit) / 100
+	if slots < 1 {
+		slots = 1
+	}
+	return slots
+}
+
+// JobCapacity returns the slots of the pool the job takes and the most slots the pool can grant it,
+// the share of its job type or the whole pool. The capacity is -1 if the pool does not limit the job.
+func (p *ResourcePool) JobCapacity(job *Job) (int, int) {
+	capacity := p.JobTypeSlots(job.JobType)
+	if capacity < 0 && p.ScalingLimit > 0 {
+		capacity = int(p.ScalingLimit)
+	}
+	return p.JobSlots(job.Resources), capacity
 }
 
 // ResourceLimitOfTask represents resource limits for a specific task type.
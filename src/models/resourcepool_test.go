@@ -0,0 +1,51 @@
+package models
+
+import (
+	"math"
+	"testing"
+)
+
+func TestJobCapacity(t *testing.T) {
+	pool := &ResourcePool{
+		ScalingLimit: 10,
+		SlotCpu:      2,
+		SlotMemoryMB: 4096,
+		ResourceLimits: map[string]*ResourceLimitOfTask{
+			"1": {JobTypeId: "1", ScalingLimit: 50},
+			"2": {JobTypeId: "2", ScalingLimit: 1},
+			"3": {JobTypeId: "3"},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		pool      *ResourcePool
+		job       Job
+		slots     int
+		typeSlots int
+		capacity  int
+	}{
+		{"not estimated", pool, Job{JobType: 1}, 1, 5, 5},
+		{"cpu", pool, Job{JobType: 1, Resources: &Resources{CpuCores: 5}}, 3, 5, 5},
+		{"memory", pool, Job{JobType: 1, Resources: &Resources{CpuCores: 1, MemoryMB: 10000}}, 3, 5, 5},
+		{"small share", pool, Job{JobType: 2}, 1, 1, 1},
+		{"share without limit", pool, Job{JobType: 3}, 1, -1, 10},
+		{"job type without share", pool, Job{JobType: 4, Resources: &Resources{CpuCores: 30}}, 15, -1, 10},
+		{"unlimited pool", &ResourcePool{SlotCpu: 2}, Job{JobType: 1, Resources: &Resources{CpuCores: 4}}, 2, -1, -1},
+		{"huge cpu", &ResourcePool{SlotCpu: 0.5}, Job{JobType: 1, Resources: &Resources{CpuCores: 1e300}}, maxJobSlots, -1, -1},
+		{"huge memory", &ResourcePool{SlotMemoryMB: 1}, Job{JobType: 1, Resources: &Resources{MemoryMB: math.MaxInt64}}, maxJobSlots, -1, -1},
+		{"no slot size", &ResourcePool{ScalingLimit: 4}, Job{JobType: 1, Resources: &Resources{CpuCores: 64, MemoryMB: 1 << 20}}, 1, -1, 4},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if typeSlots := test.pool.JobTypeSlots(test.job.JobType); typeSlots != test.typeSlots {
+				t.Errorf("JobTypeSlots = %d, want %d", typeSlots, test.typeSlots)
+			}
+			slots, capacity := test.pool.JobCapacity(&test.job)
+			if slots != test.slots || capacity != test.capacity {
+				t.Errorf("JobCapacity = %d, %d, want %d, %d", slots, capacity, test.slots, test.capacity)
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+package models
+
+import "time"
+
+// Billing periods of the usage reports
+const (
+	UsagePeriodMonth = "month" // Calendar months in UTC
+	UsagePeriodDay   = "day"   // Calendar days in UTC
+)
+
+// UsageRecord is the metered usage of a finished job, written once per job.
+type UsageRecord struct {
+	JobId          string    `json:"JobId" bson:"_id"`                               // Job the usage belongs to
+	TenantId       string    `json:"TenantId,omitempty" bson:"TenantId"`             // Organization that submitted the job
+	UserId         string    `json:"UserId,omitempty" bson:"UserId"`                 // User that submitted the job
+	ResourcePoolId string    `json:"ResourcePoolId,omitempty" bson:"ResourcePoolId"` // Pool the job ran in, empty for jobs outside of pools
+	JobType        int32     `json:"JobType" bson:"JobType"`                         // Type of the job
+	Status         string    `json:"Status" bson:"Status"`                           // Finished status of the job
+	QueueSeconds   int64     `json:"QueueSeconds" bson:"QueueSeconds"`               // Time the job waited before it started
+	ComputeSeconds int64     `json:"ComputeSeconds" bson:"ComputeSeconds"`           // Time the files of the job were converting
+	InputBytes     int64     `json:"InputBytes" bson:"InputBytes"`                   // Total size of the input files
+	CreatedAt      time.Time `json:"CreatedAt" bson:"CreatedAt"`                     // Time when the job was created
+	StartedAt      time.Time `json:"StartedAt,omitempty" bson:"StartedAt"`           // Time when the job started, zero if it never started
+	FinishedAt     time.Time `json:"FinishedAt" bson:"FinishedAt"`                   // Time when the job finished, the usage is billed in its period
+}
+
+// PoolUptime is an interval in which an exclusive pool was up, the organization that started it is charged for it.
+type PoolUptime struct {
+	UptimeId       string    `json:"UptimeId" bson:"_id"`                  // Unique identifier for the interval
+	ResourcePoolId string    `json:"ResourcePoolId" bson:"ResourcePoolId"` // Pool that was up
+	TenantId       string    `json:"TenantId,omitempty" bson:"TenantId"`   // Organization that started the pool
+	StartedAt      time.Time `json:"StartedAt" bson:"StartedAt"`           // Time when the pool was started
+	StoppedAt      time.Time `json:"StoppedAt,omitempty" bson:"StoppedAt"` // Time when the pool was stopped, zero while it is up
+}
+
+// PoolQueue counts the places taken in the queue of a pool and the slots of its running jobs. A place
+// is taken when a job is admitted and given back when it finishes, slots are taken when a job is
+// dispatched and given back when it finishes or pauses. Both are taken with a conditional update of
+// the count, so concurrent submissions and server instances never exceed the limits of the pool.
+type PoolQueue struct {
+	ResourcePoolId string    `json:"ResourcePoolId" bson:"ResourcePoolId"` // Pool of the queue
+	Reserved       int64     `json:"Reserved" bson:"Reserved"`             // Places taken by admitted jobs that did not finish yet
+	Running        int64     `json:"Running" bson:"Running"`               // Slots taken by dispatched jobs that did not finish or pause yet
+	UpdatedAt      time.Time `json:"UpdatedAt" bson:"UpdatedAt"`           // Time when a place or slot was last taken or given back
+}
+
+// Usage is the aggregated usage of an organization in a billing period.
+type Usage struct {
+	TenantId          string `json:"TenantId" bson:"TenantId"`                   // Organization
+	Period            string `json:"Period" bson:"Period"`                       // Billing period, e.g. 2024-05 or 2024-05-31
+	Jobs              int64  `json:"Jobs" bson:"Jobs"`                           // Finished jobs
+	FailedJobs        int64  `json:"FailedJobs" bson:"FailedJobs"`               // Jobs that failed or were cancelled
+	QueueSeconds      int64  `json:"QueueSeconds" bson:"QueueSeconds"`           // Time the jobs waited before they started
+	ComputeSeconds    int64  `json:"ComputeSeconds" bson:"ComputeSeconds"`       // Time the files of the jobs were converting
+	InputBytes        int64  `json:"InputBytes" bson:"InputBytes"`               // Size of the input files of the jobs
+	PoolUptimeSeconds int64  `json:"PoolUptimeSeconds" bson:"PoolUptimeSeconds"` // Time the exclusive pools of the organization were up
+}
+
+// NewUsageRecord meters the finished job.
+func NewUsageRecord(job *Job) *UsageRecord {
+	record := &UsageRecord{
+		JobId:          job.JobId,
+		TenantId:       job.TenantId,
+		UserId:         job.UserId,
+		ResourcePoolId: job.ResourcePoolId,
+		JobType:        job.JobType,
+		Status:         job.Status,
+		InputBytes:     job.InputSize,
+		CreatedAt:      job.CreatedAt,
+		StartedAt:      job.StartedAt,
+		FinishedAt:     job.FinishedAt,
+	}
+	if record.FinishedAt.IsZero() {
+		record.FinishedAt = time.Now()
+	}
+
+	// A job that never started waited until it finished
+	queuedUntil := record.FinishedAt
+	if !record.StartedAt.IsZero() {
+		queuedUntil = record.StartedAt
+	}
+	record.QueueSeconds = seconds(queuedUntil.Sub(record.CreatedAt))
+
+	// Only the conversions are charged, not the time the job was paused or waited between files
+	var computeMs int64
+	for _, file := range job.Files {
+		computeMs += file.DurationMs
+	}
+	record.ComputeSeconds = seconds(time.Duration(computeMs) * time.Millisecond)
+
+	return record
+}
+
+// UsageTenant returns the organization whose usage the caller may read. Callers read their own
+// usage, only admin organizations read other organizations and all of them with an empty tenantId.
+func UsageTenant(admins []interface{}, callerTenantId string, tenantId string) (string, bool) {
+	if callerTenantId == "" {
+		return "", false
+	}
+	for _, admin := range admins {
+		if id, ok := admin.(string); ok && id == callerTenantId {
+			return tenantId, true
+		}
+	}
+	if tenantId != "" && tenantId != callerTenantId {
+		return "", false
+	}
+	return callerTenantId, true
+}
+
+// UsagePeriodStart returns the start of the billing period containing t.
+func UsagePeriodStart(t time.Time, period string) time.Time {
+	t = t.UTC()
+	if period == UsagePeriodDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// NextUsagePeriod returns the start of the billing period after the one starting at start.
+func NextUsagePeriod(start time.Time, period string) time.Time {
+	if period == UsagePeriodDay {
+		return start.AddDate(0, 0, 1)
+	}
+	return start.AddDate(0, 1, 0)
+}
+
+// UsagePeriodName returns the name of the billing period starting at start, e.g. 2024-05 for a month.
+func UsagePeriodName(start time.Time, period string) string {
+	if period == UsagePeriodDay {
+		return start.Format("2006-01-02")
+	}
+	return start.Format("2006-01")
+}
+
+// seconds rounds the duration to whole seconds, negative durations count as 0.
+func seconds(d time.Duration) int64 {
+	if d < 0 {
+		return 0
+	}
+	return int64(d.Round(time.Second) / time.Second)
+}
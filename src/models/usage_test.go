@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.zixel.cn/go/framework"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewUsageRecord(t *testing.T) {
+	created := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		job     Job
+		queue   int64
+		compute int64
+	}{
+		{
+			name:  "never started",
+			job:   Job{CreatedAt: created, FinishedAt: created.Add(time.Minute)},
+			queue: 60,
+		},
+		{
+			// Paused an hour between the files, only the conversions are charged
+			name: "files",
+			job: Job{CreatedAt: created, StartedAt: created.Add(10 * time.Second), FinishedAt: created.Add(2 * time.Hour), Files: []FileProgress{
+				{File: "a.dwg", DurationMs: 1500},
+				{File: "b.dwg", DurationMs: 90000},
+				{File: "c.dwg"},
+			}},
+			queue:   10,
+			compute: 92,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			record := NewUsageRecord(&test.job)
+			if record.QueueSeconds != test.queue || record.ComputeSeconds != test.compute {
+				t.Errorf("QueueSeconds, ComputeSeconds = %d, %d, want %d, %d", record.QueueSeconds, record.ComputeSeconds, test.queue, test.compute)
+			}
+		})
+	}
+}
+
+func TestUsageTenant(t *testing.T) {
+	admins := []interface{}{"admin"}
+	tests := []struct {
+		name     string
+		caller   string
+		tenantId string
+		want     string
+		ok       bool
+	}{
+		{name: "own", caller: "a", tenantId: "a", want: "a", ok: true},
+		{name: "own by default", caller: "a", want: "a", ok: true},
+		{name: "other", caller: "a", tenantId: "b"},
+		{name: "no caller", tenantId: "a"},
+		{name: "admin other", caller: "admin", tenantId: "b", want: "b", ok: true},
+		{name: "admin all", caller: "admin", want: "", ok: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, ok := UsageTenant(admins, test.caller, test.tenantId)
+			if got != test.want || ok != test.ok {
+				t.Errorf("UsageTenant(%q, %q) = %q, %v, want %q, %v", test.caller, test.tenantId, got, ok, test.want, test.ok)
+			}
+		})
+	}
+}
+
+func TestUsageTenantOfCaller(t *testing.T) {
+	// The organization comes from the metadata of the call, gRPC sends its keys in lower case
+	var headers framework.CommonHeaders
+	if err := framework.DoTestRpcHeaders(metadata.Pairs("zixel-organization-id", "a"), &headers); err != nil {
+		t.Fatalf("DoTestRpcHeaders: %v", err)
+	}
+	if got, ok := UsageTenant(nil, headers.TenantId, ""); got != "a" || !ok {
+		t.Errorf("UsageTenant(%q, \"\") = %q, %v, want a, true", headers.TenantId, got, ok)
+	}
+	if _, ok := UsageTenant(nil, headers.TenantId, "b"); ok {
+		t.Errorf("UsageTenant(%q, b) is allowed, want it denied", headers.TenantId)
+	}
+}
@@ -0,0 +1,65 @@
+package models
+
+import "time"
+
+// Status values of a webhook delivery
+const (
+	WebhookDeliveryPending   = "Pending"   // Payload is waiting for its next attempt
+	WebhookDeliveryDelivered = "Delivered" // Receiver accepted the payload
+	WebhookDeliveryFailed    = "Failed"    // All attempts failed
+)
+
+// WebhookEventJobStatus is the event of the payloads sent when the status of a job changes.
+const WebhookEventJobStatus = "job.status"
+
+// Webhook is the callback URL an organization registered for the status changes of its jobs.
+type Webhook struct {
+	TenantId  string    `json:"TenantId" bson:"_id"`        // Organization the webhook belongs to
+	Url       string    `json:"Url" bson:"Url"`             // URL receiving the payloads
+	Secret    string    `json:"-" bson:"Secret"`            // Secret signing the payloads of the organization
+	Disabled  bool      `json:"Disabled" bson:"Disabled"`   // Disabled webhooks receive no payloads
+	CreatedAt time.Time `json:"CreatedAt" bson:"CreatedAt"` // Time when the webhook was registered
+	UpdatedAt time.Time `json:"UpdatedAt" bson:"UpdatedAt"` // Time when the webhook was last changed
+}
+
+// NewWebhook creates the webhook of the organization, changed at now.
+func NewWebhook(tenantId string, url string, secret string, disabled bool, now time.Time) *Webhook {
+	return &Webhook{
+		TenantId:  tenantId,
+		Url:       url,
+		Secret:    secret,
+		Disabled:  disabled,
+		UpdatedAt: now,
+	}
+}
+
+// WebhookDelivery is a payload posted to a webhook, it is the delivery log queried by the integrators.
+type WebhookDelivery struct {
+	DeliveryId    string    `json:"DeliveryId" bson:"_id"`                      // Unique identifier for the delivery
+	JobId         string    `json:"JobId" bson:"JobId"`                         // Job whose status changed
+	TenantId      string    `json:"TenantId,omitempty" bson:"TenantId"`         // Organization of the job
+	Url           string    `json:"Url" bson:"Url"`                             // URL the payload is posted to
+	JobStatus     string    `json:"JobStatus" bson:"JobStatus"`                 // Status of the job in the payload
+	Payload       string    `json:"Payload" bson:"Payload"`                     // JSON payload posted to the URL
+	Status        string    `json:"Status" bson:"Status"`                       // Status of the delivery
+	Attempts      int32     `json:"Attempts" bson:"Attempts"`                   // Number of delivery attempts
+	ResponseCode  int32     `json:"ResponseCode,omitempty" bson:"ResponseCode"` // HTTP status code of the last attempt
+	Error         string    `json:"Error,omitempty" bson:"Error"`               // Error of the last attempt
+	CreatedAt     time.Time `json:"CreatedAt" bson:"CreatedAt"`                 // Time when the delivery was created
+	NextAttemptAt time.Time `json:"NextAttemptAt" bson:"NextAttemptAt"`         // Time of the next attempt, also the lease of a running attempt
+	DeliveredAt   time.Time `json:"DeliveredAt,omitempty" bson:"DeliveredAt"`   // Time when the receiver accepted the payload
+}
+
+// WebhookPayload is the JSON body posted to the webhooks.
+type WebhookPayload struct {
+	Event         string         `json:"event"`                   // Event of the payload, WebhookEventJobStatus
+	JobId         string         `json:"jobId"`                   // Identifier of the job
+	JobType       int32          `json:"jobType"`                 // Type of the job
+	Status        string         `json:"status"`                  // Status of the job
+	Progress      int32          `json:"progress"`                // Progress of the job
+	Message       string         `json:"message,omitempty"`       // Message from the job
+	ErrorCategory string         `json:"errorCategory,omitempty"` // Category of the failure of the job
+	RetryOf       string         `json:"retryOf,omitempty"`       // Job this job retries
+	Files         []FileProgress `json:"files,omitempty"`         // Progress of each file of the job
+	OccurredAt    time.Time      `json:"occurredAt"`              // Time of the status change
+}
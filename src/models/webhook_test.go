@@ -0,0 +1,40 @@
+package models
+
+import (
+	"testing"
+	"time"
+
+	"gitlab.zixel.cn/go/framework"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewWebhookOfCaller(t *testing.T) {
+	// SetWebhook and callback URLs require the organization read from the metadata of the call,
+	// gRPC sends its keys in lower case
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name   string
+		md     metadata.MD
+		tenant string
+	}{
+		{name: "organization", md: metadata.Pairs("zixel-organization-id", "tenant"), tenant: "tenant"},
+		{name: "no organization", md: metadata.Pairs("zixel-user-id", "user")},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var headers framework.CommonHeaders
+			if err := framework.DoTestRpcHeaders(test.md, &headers); err != nil {
+				t.Fatalf("DoTestRpcHeaders: %v", err)
+			}
+			if headers.TenantId != test.tenant {
+				t.Fatalf("TenantId = %q, want %q", headers.TenantId, test.tenant)
+			}
+
+			hook := NewWebhook(headers.TenantId, "https://example.com/hook", "secret", false, now)
+			if hook.TenantId != test.tenant || hook.Url != "https://example.com/hook" || hook.Secret != "secret" || !hook.UpdatedAt.Equal(now) {
+				t.Errorf("NewWebhook = %+v, want the webhook of %q", hook, test.tenant)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Package rescript estimates the resources a job consumes from its input, as described by the ReScript of its job type.
+//
+// A ReScript is a YAML document with an expression for each resource, see package expr:
+//
+//	cpu: max(1, size_mb / 500)       # cores
+//	memory: 512 + size_mb * 4        # megabytes
+//	duration: 30 + size_mb * 1.5     # seconds
+//	extensions:                      # optional, replaces the expressions for inputs of an extension
+//	  dwg:
+//	    memory: 1024 + size_mb * 8
+//
+// The expressions use the variables size (input size in bytes), size_mb (input size in megabytes)
+// and the numbers and booleans of the job parameters under their names, booleans are 1 or 0.
+// A resource without an expression is not estimated.
+package rescript
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"transform2/expr"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Input describes the input of a job.
+type Input struct {
+	Size       int64              // Total size of the input files in bytes
+	Extension  string             // Extension of the input files in lower case without a leading dot, e.g. dwg
+	Parameters map[string]float64 // Numeric job parameters, see ParseParameters
+}
+
+// Largest estimates, larger values are clamped so they fit the integer fields of an Estimate.
+const (
+	MaxMemoryMB = 1 << 40                    // 1 EiB
+	MaxDuration = 100 * 365 * 24 * time.Hour // About 100 years
+)
+
+// Estimate is the resources a job is expected to consume, zero values were not estimated.
+type Estimate struct {
+	Cpu      float64       // CPU cores
+	MemoryMB int64         // Memory in megabytes
+	Duration time.Duration // Conversion time
+}
+
+// resources is the expression of each resource in the YAML document.
+type resources struct {
+	Cpu      string `yaml:"cpu"`
+	Memory   string `yaml:"memory"`
+	Duration string `yaml:"duration"`
+}
+
+type script struct {
+	resources  `yaml:",inline"`
+	Extensions map[string]resources `yaml:"extensions"`
+}
+
+// Estimator evaluates a compiled ReScript.
+type Estimator struct {
+	defaults   compiled
+	extensions map[string]compiled
+}
+
+// compiled holds the parsed expressions of the resources, nil is not estimated.
+type compiled struct {
+	cpu, memory, duration *expr.Expr
+}
+
+// Compile parses and checks the script. An empty script returns nil, jobs of the job type are not estimated.
+func Compile(src string) (*Estimator, error) {
+	var s script
+	decoder := yaml.NewDecoder(strings.NewReader(src))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&s); err != nil {
+		if err == io.EOF {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	e := &Estimator{extensions: make(map[string]compiled)}
+	var err error
+	if e.defaults, err = compile(s.resources); err != nil {
+		return nil, err
+	}
+	for ext, r := range s.Extensions {
+		c, err := compile(r)
+		if err != nil {
+			return nil, fmt.Errorf("extension %s: %v", ext, err)
+		}
+		e.extensions[strings.ToLower(strings.TrimPrefix(ext, "."))] = c
+	}
+
+	return e, nil
+}
+
+// compile parses the expressions of the resources.
+func compile(r resources) (compiled, error) {
+	var c compiled
+	for _, field := range []struct {
+		name string
+		src  string
+		dst  **expr.Expr
+	}{
+		{"cpu", r.Cpu, &c.cpu},
+		{"memory", r.Memory, &c.memory},
+		{"duration", r.Duration, &c.duration},
+	} {
+		if field.src == "" {
+			continue
+		}
+		parsed, err := expr.Parse(field.src)
+		if err != nil {
+			return c, fmt.Errorf("%s: %v", field.name, err)
+		}
+		*field.dst = parsed
+	}
+	return c, nil
+}
+
+// Estimate evaluates the expressions for the input. Expressions of the extension of the input
+// replace the default ones, an expression using a parameter the job does not have fails.
+// Memory and duration are clamped to MaxMemoryMB and MaxDuration.
+func (e *Estimator) Estimate(input Input) (Estimate, error) {
+	c := e.defaults
+	if ext, ok := e.extensions[input.Extension]; ok {
+		if ext.cpu != nil {
+			c.cpu = ext.cpu
+		}
+		if ext.memory != nil {
+			c.memory = ext.memory
+		}
+		if ext.duration != nil {
+			c.duration = ext.duration
+		}
+	}
+
+	vars := make(map[string]float64, len(input.Parameters)+2)
+	for name, value := range input.Parameters {
+		vars[name] = value
+	}
+	vars["size"] = float64(input.Size)
+	vars["size_mb"] = float64(input.Size) / (1 << 20)
+
+	var estimate Estimate
+	cpu, err := eval(c.cpu, vars)
+	if err != nil {
+		return estimate, fmt.Errorf("cpu: %v", err)
+	}
+	memory, err := eval(c.memory, vars)
+	if err != nil {
+		return estimate, fmt.Errorf("memory: %v", err)
+	}
+	duration, err := eval(c.duration, vars)
+	if err != nil {
+		return estimate, fmt.Errorf("duration: %v", err)
+	}
+
+	estimate.Cpu = cpu
+	estimate.MemoryMB = int64(math.Ceil(math.Min(memory, MaxMemoryMB)))
+	estimate.Duration = time.Duration(math.Min(duration, MaxDuration.Seconds()) * float64(time.Second)).Round(time.Second)
+	return estimate, nil
+}
+
+// eval evaluates the expression, a missing expression or a negative or infinite value is 0.
+func eval(e *expr.Expr, vars map[string]float64) (float64, error) {
+	if e == nil {
+		return 0, nil
+	}
+	value, err := e.Eval(vars)
+	if err != nil {
+		return 0, err
+	}
+	if value < 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return 0, nil
+	}
+	return value, nil
+}
+
+// ParseParameters returns the numbers and booleans of the job parameters, a JSON object
+// that may be base64 encoded. Parameters that are no JSON object have no numeric parameters.
+func ParseParameters(parameters string) map[string]float64 {
+	data := []byte(parameters)
+	if decoded, err := base64.StdEncoding.DecodeString(parameters); err == nil {
+		data = decoded
+	}
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	values := make(map[string]float64)
+	for name, field := range fields {
+		switch value := field.(type) {
+		case float64:
+			values[name] = value
+		case bool:
+			if value {
+				values[name] = 1
+			} else {
+				values[name] = 0
+			}
+		}
+	}
+	return values
+}
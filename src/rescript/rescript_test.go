@@ -0,0 +1,85 @@
+package rescript
+
+import (
+	"encoding/base64"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testScript = `
+cpu: max(1, size_mb / 512)
+memory: 512 + size_mb * 4
+duration: 30 + size_mb * 2 * quality
+extensions:
+  .DWG:
+    memory: 1024 + size_mb * 8
+`
+
+func TestEstimate(t *testing.T) {
+	e, err := Compile(testScript)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		input Input
+		want  Estimate
+	}{
+		{"default", Input{Size: 100 << 20, Extension: "step", Parameters: map[string]float64{"quality": 1}},
+			Estimate{Cpu: 1, MemoryMB: 912, Duration: 230 * time.Second}},
+		{"extension", Input{Size: 1024 << 20, Extension: "dwg", Parameters: map[string]float64{"quality": 0.5}},
+			Estimate{Cpu: 2, MemoryMB: 9216, Duration: 1054 * time.Second}},
+	}
+	for _, tt := range tests {
+		got, err := e.Estimate(tt.input)
+		if err != nil {
+			t.Errorf("%s: Estimate: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: Estimate = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+
+	if _, err := e.Estimate(Input{Size: 1 << 20}); err == nil || !strings.Contains(err.Error(), "quality") {
+		t.Errorf("Estimate without the quality parameter: err = %v, want an unknown variable", err)
+	}
+
+	huge, err := Compile("cpu: 1\nmemory: size * size * size\nduration: size * size * size\n")
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	want := Estimate{Cpu: 1, MemoryMB: MaxMemoryMB, Duration: MaxDuration}
+	if got, err := huge.Estimate(Input{Size: 1 << 62}); err != nil || got != want {
+		t.Errorf("Estimate of huge values = %+v, %v, want %+v", got, err, want)
+	}
+}
+
+func TestCompile(t *testing.T) {
+	if e, err := Compile("# no estimate\n"); e != nil || err != nil {
+		t.Errorf("Compile of an empty script = %v, %v, want nil, nil", e, err)
+	}
+
+	for _, src := range []string{"cpu: 1 +\n", "gpu: 1\n", "extensions:\n  dwg:\n    memory: exec(1)\n"} {
+		if _, err := Compile(src); err == nil {
+			t.Errorf("Compile(%q) succeeded, want an error", src)
+		}
+	}
+}
+
+func TestParseParameters(t *testing.T) {
+	raw := `{"quality": 0.5, "lods": 3, "textures": true, "name": "part", "files": ["a.dwg"]}`
+	want := map[string]float64{"quality": 0.5, "lods": 3, "textures": 1}
+
+	for _, parameters := range []string{raw, base64.StdEncoding.EncodeToString([]byte(raw))} {
+		if got := ParseParameters(parameters); !reflect.DeepEqual(got, want) {
+			t.Errorf("ParseParameters(%q) = %v, want %v", parameters, got, want)
+		}
+	}
+	if got := ParseParameters("not json"); got != nil {
+		t.Errorf("ParseParameters of text = %v, want nil", got)
+	}
+}
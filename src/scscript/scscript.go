@@ -0,0 +1,329 @@
+// Package scscript extracts the progress of a converter from the lines of its command line output,
+// as described by the ScScript of its job type.
+//
+// A ScScript is a YAML document with the rules applied to every output line and optional stages:
+//
+//	stages:                  # optional, the overall progress is split between the stages by weight
+//	  - name: import
+//	    weight: 6
+//	  - name: export
+//	    weight: 4
+//	rules:                   # applied in order, the first rule matching a line is used
+//	  - match: '^Loading (?P<message>.+)'
+//	    stage: import        # the rule moves the progress to this stage
+//	  - match: '^\[(?P<stage>\w+)\] (?P<done>\d+)/(?P<total>\d+)'
+//	    percent: done / total * 100
+//	  - match: '^Progress: (?P<percent>[\d.]+)%'
+//	  - match: '^WARNING: (?P<warning>.+)'
+//	  - match: '^ERROR: (?P<error>.+)'
+//
+// The named captures percent, stage, message, warning and error set the matching fields of the
+// progress. The percent expression of a rule computes the percentage from its numeric captures
+// with + - * / and parentheses instead, see package expr. Without stages the percentage is the
+// progress of the whole command, with stages it is the progress within the current stage.
+package scscript
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"transform2/expr"
+
+	"gopkg.in/yaml.v3"
+)
+
+// MaxLineSize is the longest output line a parser reads, longer lines are cut, see ReadLines.
+const MaxLineSize = 64 * 1024
+
+// Names of the captures with a meaning, other captures can only be used in percent expressions.
+const (
+	capturePercent = "percent"
+	captureStage   = "stage"
+	captureMessage = "message"
+	captureWarning = "warning"
+	captureError   = "error"
+)
+
+// Progress is the state of a command collected from its output.
+type Progress struct {
+	Percent      int32  `json:"Percent"`                // Progress of the command in percent, it never decreases
+	Stage        string `json:"Stage,omitempty"`        // Stage the command reported last
+	StagePercent int32  `json:"StagePercent,omitempty"` // Progress within the stage in percent
+	Message      string `json:"Message,omitempty"`      // Message the command reported last
+	Warning      string `json:"Warning,omitempty"`      // Warning the command reported last
+	Warnings     int32  `json:"Warnings,omitempty"`     // Number of warnings the command reported
+	Error        string `json:"Error,omitempty"`        // Error the command reported last
+}
+
+// script is the YAML document of a ScScript.
+type script struct {
+	Stages []struct {
+		Name   string  `yaml:"name"`
+		Weight float64 `yaml:"weight"`
+	} `yaml:"stages"`
+	Rules []struct {
+		Match   string `yaml:"match"`
+		Stage   string `yaml:"stage"`
+		Percent string `yaml:"percent"`
+	} `yaml:"rules"`
+}
+
+// Parser applies the rules of a ScScript to output lines.
+type Parser struct {
+	rules  []rule
+	stages map[string]stage
+}
+
+type rule struct {
+	re      *regexp.Regexp
+	stage   string     // Stage the rule moves to, empty keeps the stage
+	percent *expr.Expr // Expression computing the percentage, nil uses the percent capture
+}
+
+// stage is a part of the overall progress, in percent of the whole command.
+type stage struct {
+	start  float64
+	weight float64
+}
+
+// Compile parses and checks the script. An empty script collects no progress.
+func Compile(src string) (*Parser, error) {
+	var s script
+	decoder := yaml.NewDecoder(strings.NewReader(src))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&s); err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	p := &Parser{}
+	if len(s.Stages) > 0 {
+		total := 0.0
+		for i, st := range s.Stages {
+			if st.Name == "" {
+				return nil, fmt.Errorf("stage %d: name is required", i+1)
+			}
+			if st.Weight <= 0 {
+				return nil, fmt.Errorf("stage %s: weight must be positive", st.Name)
+			}
+			total += st.Weight
+		}
+
+		p.stages = make(map[string]stage)
+		start := 0.0
+		for _, st := range s.Stages {
+			if _, ok := p.stages[st.Name]; ok {
+				return nil, fmt.Errorf("stage %s is declared twice", st.Name)
+			}
+			weight := st.Weight * 100 / total
+			p.stages[st.Name] = stage{start: start, weight: weight}
+			start += weight
+		}
+	}
+
+	for i, spec := range s.Rules {
+		r, err := p.compileRule(spec.Match, spec.Stage, spec.Percent)
+		if err != nil {
+			return nil, fmt.Errorf("rule %d: %v", i+1, err)
+		}
+		p.rules = append(p.rules, r)
+	}
+
+	return p, nil
+}
+
+// compileRule checks the rule against the stages of the script. Every capture has to be
+// one with a meaning or be used by the percent expression, so misspelled captures are found.
+func (p *Parser) compileRule(match string, stageName string, percent string) (rule, error) {
+	if match == "" {
+		return rule{}, fmt.Errorf("match is required")
+	}
+	re, err := regexp.Compile(match)
+	if err != nil {
+		return rule{}, err
+	}
+	r := rule{re: re, stage: stageName}
+
+	if stageName != "" && p.stages != nil {
+		if _, ok := p.stages[stageName]; !ok {
+			return rule{}, fmt.Errorf("stage %s is not declared", stageName)
+		}
+	}
+
+	captures := make(map[string]bool)
+	for _, name := range re.SubexpNames() {
+		if name != "" {
+			captures[name] = true
+		}
+	}
+
+	used := make(map[string]bool)
+	if percent != "" {
+		if r.percent, err = expr.Parse(percent); err != nil {
+			return rule{}, err
+		}
+		for _, name := range r.percent.Vars() {
+			if !captures[name] {
+				return rule{}, fmt.Errorf("percent uses %s, which is not captured", name)
+			}
+			used[name] = true
+		}
+	}
+
+	for name := range captures {
+		switch name {
+		case capturePercent, captureStage, captureMessage, captureWarning, captureError:
+		default:
+			if !used[name] {
+				return rule{}, fmt.Errorf("capture %s is not used", name)
+			}
+		}
+	}
+
+	return r, nil
+}
+
+// Parse applies the first matching rule to the output line and returns true if the progress changed.
+// Percentages that cannot be computed from the line, e.g. because of a division by zero, are ignored.
+func (p *Parser) Parse(line string, progress *Progress) bool {
+	for _, r := range p.rules {
+		match := r.re.FindStringSubmatch(line)
+		if match == nil {
+			continue
+		}
+
+		captures := make(map[string]string)
+		for i, name := range r.re.SubexpNames() {
+			if name != "" && i < len(match) {
+				captures[name] = match[i]
+			}
+		}
+
+		next := *progress
+		stageName := r.stage
+		if captures[captureStage] != "" {
+			stageName = captures[captureStage]
+		}
+		if stageName != "" && stageName != next.Stage {
+			next.Stage, next.StagePercent = stageName, 0
+		}
+		if message, ok := captures[captureMessage]; ok {
+			next.Message = message
+		}
+		if warning, ok := captures[captureWarning]; ok {
+			next.Warning = warning
+			next.Warnings++
+		}
+		if err, ok := captures[captureError]; ok {
+			next.Error = err
+		}
+		if percent, ok := r.evalPercent(captures); ok {
+			next.StagePercent = clampPercent(percent)
+		}
+
+		if percent := p.overall(&next); percent > next.Percent {
+			next.Percent = percent
+		}
+
+		changed := next != *progress
+		*progress = next
+		return changed
+	}
+	return false
+}
+
+// evalPercent returns the percentage reported by the captures of the rule.
+func (r *rule) evalPercent(captures map[string]string) (float64, bool) {
+	if r.percent == nil {
+		value, ok := captures[capturePercent]
+		if !ok {
+			return 0, false
+		}
+		percent, err := strconv.ParseFloat(value, 64)
+		return percent, err == nil
+	}
+
+	vars := make(map[string]float64)
+	for _, name := range r.percent.Vars() {
+		value, err := strconv.ParseFloat(captures[name], 64)
+		if err != nil {
+			return 0, false
+		}
+		vars[name] = value
+	}
+	percent, err := r.percent.Eval(vars)
+	return percent, err == nil
+}
+
+// overall returns the progress of the whole command. Without stages it is the progress of the
+// current stage, a stage that is not declared keeps the overall progress where it is.
+func (p *Parser) overall(progress *Progress) int32 {
+	if p.stages == nil {
+		return progress.StagePercent
+	}
+	st, ok := p.stages[progress.Stage]
+	if !ok {
+		return progress.Percent
+	}
+	return clampPercent(st.start + st.weight*float64(progress.StagePercent)/100)
+}
+
+// Snapshot is the progress after an output line changed it.
+type Snapshot struct {
+	Line     int      `json:"Line"`     // Number of the line, starting at 1
+	Progress Progress `json:"Progress"` // Progress after the line
+}
+
+// Replay applies the parser to every line of the recorded output and returns the progress after every change.
+// Lines longer than MaxLineSize are cut like the lines a worker reads.
+func (p *Parser) Replay(output io.Reader) ([]Snapshot, error) {
+	var snapshots []Snapshot
+	var progress Progress
+
+	line := 0
+	err := ReadLines(output, func(text string) {
+		line++
+		if p.Parse(text, &progress) {
+			snapshots = append(snapshots, Snapshot{Line: line, Progress: progress})
+		}
+	})
+
+	return snapshots, err
+}
+
+// ReadLines calls fn with every line of the output until its end, without the line break.
+// Lines longer than MaxLineSize are cut to MaxLineSize bytes, the rest of the line is read and dropped.
+func ReadLines(output io.Reader, fn func(line string)) error {
+	reader := bufio.NewReader(output)
+	var line []byte
+	for {
+		fragment, isPrefix, err := reader.ReadLine()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if room := MaxLineSize - len(line); len(fragment) > room {
+			fragment = fragment[:room]
+		}
+		line = append(line, fragment...)
+		if !isPrefix {
+			fn(string(line))
+			line = line[:0]
+		}
+	}
+}
+
+// clampPercent rounds the percentage down into the range 0 to 100.
+func clampPercent(percent float64) int32 {
+	switch {
+	case percent < 0:
+		return 0
+	case percent > 100:
+		return 100
+	}
+	return int32(percent)
+}
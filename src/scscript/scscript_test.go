@@ -0,0 +1,174 @@
+package scscript
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "rewrite the expected progress of the testdata")
+
+// TestReplay replays the recorded output of every converter in testdata/<converter>/output.log
+// through its script.yaml and compares the progress snapshots with expected.json.
+// Run the test with -update to record the snapshots after changing a script or the parser.
+func TestReplay(t *testing.T) {
+	dirs, err := filepath.Glob(filepath.Join("testdata", "*"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) == 0 {
+		t.Fatal("no recorded converter output in testdata")
+	}
+
+	for _, dir := range dirs {
+		dir := dir
+		t.Run(filepath.Base(dir), func(t *testing.T) {
+			src, err := os.ReadFile(filepath.Join(dir, "script.yaml"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			parser, err := Compile(string(src))
+			if err != nil {
+				t.Fatalf("Compile: %v", err)
+			}
+
+			output, err := os.Open(filepath.Join(dir, "output.log"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer output.Close()
+
+			snapshots, err := parser.Replay(output)
+			if err != nil {
+				t.Fatalf("Replay: %v", err)
+			}
+			got, err := json.MarshalIndent(snapshots, "", "  ")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got = append(got, '\n')
+
+			expectedPath := filepath.Join(dir, "expected.json")
+			if *update {
+				if err := os.WriteFile(expectedPath, got, 0644); err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			want, err := os.ReadFile(expectedPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, want) {
+				t.Errorf("progress of %s differs from %s:\n%s", output.Name(), expectedPath, got)
+			}
+
+			for i := 1; i < len(snapshots); i++ {
+				if snapshots[i].Progress.Percent < snapshots[i-1].Progress.Percent {
+					t.Errorf("progress decreased on line %d", snapshots[i].Line)
+				}
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	parser, err := Compile(`
+rules:
+  - match: '^(?P<done>\d+) of (?P<total>\d+)'
+    percent: done / total * 100
+  - match: '^(?P<percent>[\d.]+)%'
+  - match: '^(?P<percent>.*)'
+`)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	var progress Progress
+	steps := []struct {
+		line    string
+		changed bool
+		percent int32
+	}{
+		{"1 of 4", true, 25},
+		{"1 of 4", false, 25},
+		{"3 of 0", false, 25}, // Division by zero is ignored
+		{"12.5%", true, 25},   // The overall progress never decreases
+		{"250%", true, 100},   // Percentages are clamped
+		{"unparsable", false, 100},
+	}
+	for _, step := range steps {
+		changed := parser.Parse(step.line, &progress)
+		if changed != step.changed || progress.Percent != step.percent {
+			t.Errorf("Parse(%q) = %v with %d%%, want %v with %d%%", step.line, changed, progress.Percent, step.changed, step.percent)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   string
+	}{
+		{"unknown field", "rules:\n  - regex: 'x'\n", "field regex not found"},
+		{"missing match", "rules:\n  - stage: load\n", "match is required"},
+		{"invalid regex", "rules:\n  - match: '('\n", "missing closing )"},
+		{"misspelled capture", "rules:\n  - match: '(?P<precent>\\d+)'\n", "capture precent is not used"},
+		{"uncaptured variable", "rules:\n  - match: '(?P<done>\\d+)'\n    percent: done / total\n", "total, which is not captured"},
+		{"invalid expression", "rules:\n  - match: '(?P<done>\\d+)'\n    percent: done /\n", "unexpected end"},
+		{"undeclared stage", "stages:\n  - {name: load, weight: 1}\nrules:\n  - match: 'x'\n    stage: save\n", "stage save is not declared"},
+		{"zero weight", "stages:\n  - {name: load, weight: 0}\n", "weight must be positive"},
+		{"duplicate stage", "stages:\n  - {name: load, weight: 1}\n  - {name: load, weight: 1}\n", "declared twice"},
+	}
+	for _, tt := range tests {
+		_, err := Compile(tt.script)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: err = %v, want it to contain %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestReadLines(t *testing.T) {
+	long := strings.Repeat("x", MaxLineSize+10)
+	output := "first\r\n" + long + "\nlast"
+
+	var lines []string
+	if err := ReadLines(strings.NewReader(output), func(line string) { lines = append(lines, line) }); err != nil {
+		t.Fatalf("ReadLines: %v", err)
+	}
+	want := []string{"first", long[:MaxLineSize], "last"}
+	if len(lines) != len(want) {
+		t.Fatalf("ReadLines returned %d lines, want %d", len(lines), len(want))
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Errorf("line %d has %d bytes, want %d", i+1, len(lines[i]), len(want[i]))
+		}
+	}
+}
+
+func TestReplayLongLine(t *testing.T) {
+	parser, err := Compile("rules:\n  - match: '^Progress: (?P<percent>\\d+)%'\n")
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	// The cut line keeps its progress, the lines after it are still read
+	output := "Progress: 10%\nProgress: 20% " + strings.Repeat("x", 2*MaxLineSize) + "\nProgress: 30%\n"
+	snapshots, err := parser.Replay(strings.NewReader(output))
+	if err != nil {
+		t.Fatalf("Replay: %v", err)
+	}
+	var got []int32
+	for _, snapshot := range snapshots {
+		got = append(got, snapshot.Progress.Percent)
+	}
+	if len(got) != 3 || got[0] != 10 || got[1] != 20 || got[2] != 30 || snapshots[2].Line != 3 {
+		t.Errorf("Replay = %+v, want 10%%, 20%% and 30%% on lines 1 to 3", snapshots)
+	}
+}
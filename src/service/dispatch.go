@@ -0,0 +1,197 @@
+package service
+
+import (
+	"context"
+	"math"
+	"time"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// GetResourcePoolOfJobType returns the Resource Pool that limits the given job type, nil if there is none.
+func GetResourcePoolOfJobType(ctx context.Context, jobTypeId string) (*models.ResourcePool, error) {
+	var pool models.ResourcePool
+	filter := bson.M{"ResourceLimits." + jobTypeId: bson.M{"$exists": true}}
+	if err := config.RpTypeCollection.FindOne(ctx, filter).Decode(&pool); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Errorf("Error getting the resource pool of job type %s: %v", jobTypeId, err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &pool, nil
+}
+
+// ClaimJobDispatch marks the queued Job as dispatched with the slots it took in its pool, it returns
+// false if another server instance or a cancellation claimed it first.
+func ClaimJobDispatch(ctx context.Context, jobId string, slots int) (bool, error) {
+	now := time.Now()
+	filter := bson.M{"JobId": jobId, "DispatchedAt": time.Time{}}
+	set := bson.M{"DispatchedAt": now, "UpdatedAt": now}
+	if slots > 0 {
+		set["PoolSlots"] = slots
+	}
+
+	result, err := config.JobsCollection.UpdateOne(ctx, filter, bson.M{"$set": set})
+	if err != nil {
+		log.Errorf("Error claiming the dispatch of job %s: %v", jobId, err)
+		return false, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// ReleaseJobDispatch marks the dispatched Job as waiting again, so the dispatcher claims it once more.
+func ReleaseJobDispatch(ctx context.Context, jobId string) error {
+	filter := bson.M{"JobId": jobId, "DispatchedAt": bson.M{"$ne": time.Time{}}}
+	update := bson.M{"$set": bson.M{"DispatchedAt": time.Time{}, "UpdatedAt": time.Now()}}
+
+	if _, err := config.JobsCollection.UpdateOne(ctx, filter, update); err != nil {
+		log.Errorf("Error releasing the dispatch of job %s: %v", jobId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// GetPoolQueue returns the counts of the Resource Pool, zero counts if nothing was taken yet.
+func GetPoolQueue(ctx context.Context, poolId string) (*models.PoolQueue, error) {
+	queue := models.PoolQueue{ResourcePoolId: poolId}
+	if err := config.PoolQueueCollection.FindOne(ctx, bson.M{"ResourcePoolId": poolId}).Decode(&queue); err != nil && err != mongo.ErrNoDocuments {
+		log.Errorf("Error getting the queue of resource pool %s: %v", poolId, err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &queue, nil
+}
+
+// ReservePoolQueue takes a place in the queue of the Resource Pool, it returns false if all
+// limit places are taken.
+func ReservePoolQueue(ctx context.Context, poolId string, limit int64) (bool, error) {
+	return reservePoolCount(ctx, poolId, "Reserved", 1, limit)
+}
+
+// ReleasePoolQueue gives back places taken by ReservePoolQueue.
+func ReleasePoolQueue(ctx context.Context, poolId string, places int64) error {
+	return releasePoolCount(ctx, poolId, "Reserved", places)
+}
+
+// ReservePoolSlots takes slots of the Resource Pool for a dispatched job, it returns false if
+// fewer than slots of the limit slots are free. A limit of 0 does not restrict the pool.
+func ReservePoolSlots(ctx context.Context, poolId string, slots int64, limit int64) (bool, error) {
+	if limit <= 0 {
+		limit = math.MaxInt64
+	}
+	return reservePoolCount(ctx, poolId, "Running", slots, limit)
+}
+
+// ReleasePoolSlots gives back slots taken by ReservePoolSlots.
+func ReleasePoolSlots(ctx context.Context, poolId string, slots int64) error {
+	return releasePoolCount(ctx, poolId, "Running", slots)
+}
+
+// reservePoolCount adds count to the field of the counts of the Resource Pool, it returns false if the
+// field would exceed limit. The counts of the pool are created with the first reservation.
+func reservePoolCount(ctx context.Context, poolId string, field string, count int64, limit int64) (bool, error) {
+	if count > limit {
+		return false, nil
+	}
+	// A count the pool does not have yet is zero
+	filter := bson.M{"ResourcePoolId": poolId, field: bson.M{"$not": bson.M{"$gt": limit - count}}}
+	update := bson.M{"$inc": bson.M{field: count}, "$set": bson.M{"UpdatedAt": time.Now()}}
+
+	result, err := config.PoolQueueCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Errorf("Error reserving %s of resource pool %s: %v", field, poolId, err)
+		return false, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	if result.ModifiedCount == 1 {
+		return true, nil
+	}
+
+	// The count is either full or missing, a concurrent reservation may create it first
+	if _, err := config.PoolQueueCollection.InsertOne(ctx, bson.M{"ResourcePoolId": poolId, field: count, "UpdatedAt": time.Now()}); err != nil {
+		if !mongo.IsDuplicateKeyError(err) {
+			log.Errorf("Error creating the queue of resource pool %s: %v", poolId, err)
+			return false, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+		}
+		if result, err = config.PoolQueueCollection.UpdateOne(ctx, filter, update); err != nil {
+			log.Errorf("Error reserving %s of resource pool %s: %v", field, poolId, err)
+			return false, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+		}
+		return result.ModifiedCount == 1, nil
+	}
+
+	return true, nil
+}
+
+// releasePoolCount subtracts count from the field of the counts of the Resource Pool, the count never drops below zero.
+func releasePoolCount(ctx context.Context, poolId string, field string, count int64) error {
+	filter := bson.M{"ResourcePoolId": poolId, field: bson.M{"$gte": count}}
+	update := bson.M{"$inc": bson.M{field: -count}, "$set": bson.M{"UpdatedAt": time.Now()}}
+
+	if _, err := config.PoolQueueCollection.UpdateOne(ctx, filter, update); err != nil {
+		log.Errorf("Error releasing %s of resource pool %s: %v", field, poolId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// ClaimJobQueueRelease clears the queue place of the Job, it returns false if the Job holds no
+// place or another server instance cleared it first, so a place is given back only once.
+func ClaimJobQueueRelease(ctx context.Context, jobId string) (bool, error) {
+	filter := bson.M{"JobId": jobId, "QueueReserved": true}
+	update := bson.M{"$unset": bson.M{"QueueReserved": ""}}
+
+	result, err := config.JobsCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Errorf("Error claiming the queue release of job %s: %v", jobId, err)
+		return false, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// ClaimJobSlotsRelease clears the pool slots of the Job, it returns false if the Job holds no
+// slots or another server instance cleared them first, so slots are given back only once.
+func ClaimJobSlotsRelease(ctx context.Context, jobId string) (bool, error) {
+	filter := bson.M{"JobId": jobId, "PoolSlots": bson.M{"$gt": 0}}
+	update := bson.M{"$unset": bson.M{"PoolSlots": ""}}
+
+	result, err := config.JobsCollection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		log.Errorf("Error claiming the slots release of job %s: %v", jobId, err)
+		return false, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// GetTenantWeights returns the weights of the organizations with an enabled equity.
+func GetTenantWeights(ctx context.Context) (map[string]int, error) {
+	cursor, err := config.EquityCollection.Find(ctx, bson.M{"isEnable": true})
+	if err != nil {
+		log.Errorf("Error querying EquityCollection: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var equities []models.Equity
+	if err := cursor.All(ctx, &equities); err != nil {
+		log.Errorf("Error decoding equities: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, "Error Decoding Results")
+	}
+
+	weights := make(map[string]int)
+	for _, equity := range equities {
+		weights[equity.TenantId] = equity.Weight
+	}
+
+	return weights, nil
+}
@@ -0,0 +1,52 @@
+package service
+
+import (
+	"context"
+	"time"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ClaimIdempotencyKey binds the key of the tenant to the job until the key expires.
+// If the key is already bound to another job, the ID of that job is returned and nothing is changed.
+func ClaimIdempotencyKey(ctx context.Context, tenantId string, key string, jobId string, expire time.Duration) (string, error) {
+	now := time.Now()
+	claim := models.NewIdempotencyKey(tenantId, key, jobId, now, expire)
+
+	// The filter only matches an expired key, for a live key the upsert collides on _id
+	filter := bson.M{"_id": claim.Id, "ExpireAt": bson.M{"$lte": now}}
+	update := bson.M{"$set": claim}
+
+	_, err := config.IdempotencyKeyCollection.UpdateOne(ctx, filter, update, options.Update().SetUpsert(true))
+	if err == nil {
+		return "", nil
+	}
+	if !mongo.IsDuplicateKeyError(err) {
+		log.Errorf("Error claiming the idempotency key: %v", err)
+		return "", framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	var claimed models.IdempotencyKey
+	if err := config.IdempotencyKeyCollection.FindOne(ctx, bson.M{"_id": claim.Id}).Decode(&claimed); err != nil {
+		log.Errorf("Error getting the idempotency key from the database: %v", err)
+		return "", framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return claimed.JobId, nil
+}
+
+// ReleaseIdempotencyKey removes the key of the tenant if it is still bound to the job.
+func ReleaseIdempotencyKey(ctx context.Context, tenantId string, key string, jobId string) error {
+	filter := bson.M{"_id": models.IdempotencyKeyId(tenantId, key), "JobId": jobId}
+	if _, err := config.IdempotencyKeyCollection.DeleteOne(ctx, filter); err != nil {
+		log.Errorf("Error releasing the idempotency key: %v", err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
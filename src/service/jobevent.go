@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"gitlab.zixel.cn/go/framework/database"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// jobEventSeqKey is the setting holding the last allocated job event sequence number.
+const jobEventSeqKey = "jobEventSeq"
+
+// nextJobEventSeq allocates the next job event sequence number, shared by all server instances.
+// Errors are returned as they are, so a transaction can retry on a write conflict.
+func nextJobEventSeq(ctx context.Context) (int64, error) {
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+	res := database.Mongo_Setting.Get().FindOneAndUpdate(ctx,
+		bson.M{"key": jobEventSeqKey},
+		bson.M{"$inc": bson.M{"value": int64(1)}},
+		opts,
+	)
+
+	var setting struct {
+		Value int64 `bson:"value"`
+	}
+	if err := res.Decode(&setting); err != nil {
+		return 0, err
+	}
+
+	return setting.Value, nil
+}
+
+// AddJobEvent assigns the next sequence number to the JobEvent and stores it in the Database.
+// The number is allocated and the event inserted in one transaction. A concurrent writer conflicts
+// on the sequence number until the transaction commits, so events become visible in the order of
+// their numbers and a watcher reading after the last number it received never skips one.
+func AddJobEvent(ctx context.Context, event *models.JobEvent) error {
+	session, err := config.JobEventCollection.Database().Client().StartSession()
+	if err != nil {
+		log.Errorf("Error starting a session for the job event: %v", err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		seq, err := nextJobEventSeq(sc)
+		if err != nil {
+			return nil, err
+		}
+		event.Seq = seq
+		return config.JobEventCollection.InsertOne(sc, event)
+	})
+	if err != nil {
+		log.Errorf("Error adding the job event to the database: %v", err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// GetJobEvents returns at most limit JobEvents matching the filter with a sequence number after seq, oldest first.
+func GetJobEvents(ctx context.Context, filter bson.M, seq int64, limit int64) ([]models.JobEvent, error) {
+	query := bson.M{"Seq": bson.M{"$gt": seq}}
+	for k, v := range filter {
+		query[k] = v
+	}
+
+	opts := options.Find().SetSort(bson.D{{Key: "Seq", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cursor, err := config.JobEventCollection.Find(ctx, query, opts)
+	if err != nil {
+		log.Errorf("Error querying JobEventCollection: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var events []models.JobEvent
+	if err := cursor.All(ctx, &events); err != nil {
+		log.Errorf("Error decoding job events: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, "Error Decoding Results")
+	}
+
+	return events, nil
+}
+
+// GetLastJobEventSeq returns the sequence number of the newest JobEvent, 0 if there is none.
+func GetLastJobEventSeq(ctx context.Context) (int64, error) {
+	var event models.JobEvent
+	opts := options.FindOne().SetSort(bson.D{{Key: "Seq", Value: -1}})
+	if err := config.JobEventCollection.FindOne(ctx, bson.M{}, opts).Decode(&event); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return 0, nil
+		}
+		log.Errorf("Error getting the last job event from the database: %v", err)
+		return 0, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return event.Seq, nil
+}
@@ -74,6 +74,21 @@ func GetJobType(ctx context.Context, req *services.C2S_GetJobTypeReqT) (*models.
 
 }
 
+// GetJobTypeById returns the registered JobType with the given ID.
+func GetJobTypeById(ctx context.Context, jobTypeId string) (*models.JobType, error) {
+	var jobType models.JobType
+	filter := bson.M{"JobTypeId": jobTypeId}
+	if err := config.JobTypeCollection.FindOne(ctx, filter).Decode(&jobType); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Unknown Job Type "+jobTypeId)
+		}
+		log.Errorf("Error getting the job type from the database: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &jobType, nil
+}
+
 // AddJobType stores the JobType in the Database.
 func AddJobType(ctx context.Context, jobType *models.JobType) (*string, error) {
 	// Add the Record to the Database
@@ -111,6 +126,10 @@ func UpdateJobType(ctx context.Context, jobType models.JobType) error {
 			{"ScScript", jobType.ScScript},
 			{"JeScript", jobType.JeScript},
 			{"FixedParameters", jobType.FixedParameters},
+			{"TaskQueue", jobType.TaskQueue},
+			{"Workflow", jobType.Workflow},
+			{"RetryPolicy", jobType.RetryPolicy},
+			{"Timeouts", jobType.Timeouts},
 		}},
 	}
 
@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"time"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ClaimJobOutbox returns a Job with unpublished events and leases its outbox until the given time,
+// so no other server instance publishes the events meanwhile. It returns nil if no outbox is due.
+// Only the ID and the outbox of the job are loaded.
+func ClaimJobOutbox(ctx context.Context, leaseEnd time.Time) (*models.Job, error) {
+	filter := bson.M{
+		"Outbox.0": bson.M{"$exists": true},
+		"$or": bson.A{
+			bson.M{"OutboxLeaseEnd": bson.M{"$exists": false}},
+			bson.M{"OutboxLeaseEnd": bson.M{"$lte": time.Now()}},
+		},
+	}
+	update := bson.M{"$set": bson.M{"OutboxLeaseEnd": leaseEnd}}
+	opts := options.FindOneAndUpdate().SetProjection(bson.M{"JobId": 1, "Outbox": 1})
+
+	var job models.Job
+	if err := config.JobsCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&job); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Errorf("Error claiming a job outbox: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &job, nil
+}
+
+// ReleaseJobOutbox removes the published events from the outbox of the Job and ends its lease.
+func ReleaseJobOutbox(ctx context.Context, jobId string, published []string) error {
+	update := bson.M{"$unset": bson.M{"OutboxLeaseEnd": ""}}
+	if len(published) > 0 {
+		update["$pull"] = bson.M{"Outbox": bson.M{"EventId": bson.M{"$in": published}}}
+	}
+
+	if _, err := config.JobsCollection.UpdateOne(ctx, bson.M{"JobId": jobId}, update); err != nil {
+		log.Errorf("Error releasing the outbox of job %s: %v", jobId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
@@ -0,0 +1,155 @@
+package service
+
+import (
+	"context"
+	"time"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddQuota adds the Quota to the Database.
+func AddQuota(ctx context.Context, quota *models.Quota) error {
+	if _, err := config.QuotaCollection.InsertOne(ctx, quota); err != nil {
+		log.Errorf("Error adding quota %s: %v", quota.QuotaId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	return nil
+}
+
+// GetQuota returns the Quota with the given ID.
+func GetQuota(ctx context.Context, quotaId string) (*models.Quota, error) {
+	var quota models.Quota
+	if err := config.QuotaCollection.FindOne(ctx, bson.M{"QuotaId": quotaId}).Decode(&quota); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Quota "+quotaId+" not found")
+		}
+		log.Errorf("Error getting quota %s: %v", quotaId, err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &quota, nil
+}
+
+// UpdateQuota replaces the settings of the Quota, the used conversions are kept.
+func UpdateQuota(ctx context.Context, quota *models.Quota) error {
+	update := bson.M{"$set": bson.M{
+		"TenantId":             quota.TenantId,
+		"UserId":               quota.UserId,
+		"AvailableConversions": quota.AvailableConversions,
+		"ValidFrom":            quota.ValidFrom,
+		"ValidUntil":           quota.ValidUntil,
+		"JobTypes":             quota.JobTypes,
+		"TargetFormats":        quota.TargetFormats,
+		"IsEnable":             quota.IsEnable,
+		"UpdatedAt":            quota.UpdatedAt,
+	}}
+
+	result, err := config.QuotaCollection.UpdateOne(ctx, bson.M{"QuotaId": quota.QuotaId}, update)
+	if err != nil {
+		log.Errorf("Error updating quota %s: %v", quota.QuotaId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	if result.MatchedCount == 0 {
+		return framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Quota "+quota.QuotaId+" not found")
+	}
+
+	return nil
+}
+
+// RemoveQuota removes the Quota with the given ID from the Database.
+func RemoveQuota(ctx context.Context, quotaId string) error {
+	result, err := config.QuotaCollection.DeleteOne(ctx, bson.M{"QuotaId": quotaId})
+	if err != nil {
+		log.Errorf("Error removing quota %s: %v", quotaId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	if result.DeletedCount == 0 {
+		return framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Quota "+quotaId+" not found")
+	}
+
+	return nil
+}
+
+// QueryQuotas returns the Quotas matching the filter, a limit of 0 returns all of them.
+func QueryQuotas(ctx context.Context, filter bson.M, skip int64, limit int64) ([]models.Quota, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "CreatedAt", Value: 1}}).SetSkip(skip)
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cursor, err := config.QuotaCollection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Errorf("Error querying QuotaCollection: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var quotas []models.Quota
+	if err := cursor.All(ctx, &quotas); err != nil {
+		log.Errorf("Error decoding quotas: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, "Error Decoding Results")
+	}
+
+	return quotas, nil
+}
+
+// GetQuotasOf returns the enabled Quotas that apply to the user of the organization. The organization
+// is required, without it the quotas that apply are unknown.
+func GetQuotasOf(ctx context.Context, tenantId string, userId string) ([]models.Quota, error) {
+	if tenantId == "" {
+		return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "Organization is required to check the Quotas")
+	}
+
+	or := bson.A{bson.M{"TenantId": tenantId, "UserId": bson.M{"$in": bson.A{"", userId}}}}
+	if userId != "" {
+		or = append(or, bson.M{"TenantId": "", "UserId": userId})
+	}
+
+	return QueryQuotas(ctx, bson.M{"IsEnable": true, "$or": or}, 0, 0)
+}
+
+// ConsumeQuota takes the given number of conversions from the Quota, it returns false
+// if the quota does not have enough conversions left. Unlimited quotas only count the conversions.
+func ConsumeQuota(ctx context.Context, quotaId string, conversions int64) (bool, error) {
+	filter := bson.M{"QuotaId": quotaId, "$or": bson.A{
+		bson.M{"AvailableConversions": bson.M{"$gte": conversions}},
+		bson.M{"AvailableConversions": models.QuotaUnlimited},
+	}}
+
+	result, err := config.QuotaCollection.UpdateOne(ctx, filter, quotaConversionsUpdate(conversions))
+	if err != nil {
+		log.Errorf("Error consuming quota %s: %v", quotaId, err)
+		return false, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return result.ModifiedCount == 1, nil
+}
+
+// RefundQuota returns conversions taken by ConsumeQuota to the Quota.
+func RefundQuota(ctx context.Context, quotaId string, conversions int64) error {
+	if _, err := config.QuotaCollection.UpdateOne(ctx, bson.M{"QuotaId": quotaId}, quotaConversionsUpdate(-conversions)); err != nil {
+		log.Errorf("Error refunding quota %s: %v", quotaId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// quotaConversionsUpdate returns the update pipeline moving conversions from available to used,
+// the available conversions of unlimited quotas stay unlimited.
+func quotaConversionsUpdate(conversions int64) mongo.Pipeline {
+	return mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"AvailableConversions": bson.M{"$cond": bson.A{
+			bson.M{"$eq": bson.A{"$AvailableConversions", models.QuotaUnlimited}},
+			models.QuotaUnlimited,
+			bson.M{"$subtract": bson.A{"$AvailableConversions", conversions}},
+		}},
+		"UsedConversions": bson.M{"$add": bson.A{"$UsedConversions", conversions}},
+		"UpdatedAt":       time.Now(),
+	}}}}
+}
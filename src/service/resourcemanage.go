@@ -88,7 +88,9 @@ func UpdateResourcePool(ctx context.Context, rp models.ResourcePool) error {
 			{"QueueLimit", rp.QueueLimit},
 			{"Fixed", rp.Fixed},
 			{"DefaultTaskSet", rp.DefaultTaskSet},
-			{"ResourceLimit", rp.ResourceLimits},
+			{"ResourceLimits", rp.ResourceLimits},
+			{"SlotCpu", rp.SlotCpu},
+			{"SlotMemoryMB", rp.SlotMemoryMB},
 		}},
 	}
 
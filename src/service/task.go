@@ -0,0 +1,135 @@
+package service
+
+import (
+	"context"
+	"time"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddJob stores the Job in the Database.
+func AddJob(ctx context.Context, job *models.Job) error {
+	if _, err := config.JobsCollection.InsertOne(ctx, job); err != nil {
+		log.Errorf("Error adding the job to the database: %v", err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// AddJobs stores all Jobs in the Database with a single insert.
+func AddJobs(ctx context.Context, jobs []*models.Job) error {
+	docs := make([]interface{}, len(jobs))
+	for i, job := range jobs {
+		docs[i] = job
+	}
+
+	if _, err := config.JobsCollection.InsertMany(ctx, docs); err != nil {
+		log.Errorf("Error adding the jobs to the database: %v", err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// GetJob returns the Job with the given ID.
+func GetJob(ctx context.Context, jobId string) (*models.Job, error) {
+	var job models.Job
+	filter := bson.M{"JobId": jobId}
+	if err := config.JobsCollection.FindOne(ctx, filter).Decode(&job); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, framework.NewServiceError(framework.ERR_SYS_PARAMETER, "No Such Job in the Database")
+		}
+		log.Errorf("Error getting the job from the database: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &job, nil
+}
+
+// UpdateJob sets the given fields on the Job and refreshes its UpdatedAt time if it is not given.
+// The events are added to the outbox of the job in the same write.
+func UpdateJob(ctx context.Context, jobId string, fields bson.M, events ...models.OutboxEvent) error {
+	if _, ok := fields["UpdatedAt"]; !ok {
+		fields["UpdatedAt"] = time.Now()
+	}
+	filter := bson.M{"JobId": jobId}
+	update := bson.M{"$set": fields}
+	if len(events) > 0 {
+		update["$push"] = bson.M{"Outbox": bson.M{"$each": events}}
+	}
+	if _, err := config.JobsCollection.UpdateOne(ctx, filter, update); err != nil {
+		log.Errorf("Error updating the job in the database: %v", err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// FinishJob sets the finished status, error category and message of the Job and records when it finished.
+// The events are added to the outbox of the job in the same write.
+func FinishJob(ctx context.Context, jobId string, status string, category string, message string, events ...models.OutboxEvent) error {
+	return UpdateJob(ctx, jobId, bson.M{"Status": status, "ErrorCategory": category, "Message": message, "FinishedAt": time.Now()}, events...)
+}
+
+// GetJobs returns the Jobs matching the filter in the given order, limit 0 returns all of them.
+func GetJobs(ctx context.Context, filter bson.M, sort bson.D, limit int64) ([]models.Job, error) {
+	opts := options.Find()
+	if sort != nil {
+		opts.SetSort(sort)
+	}
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cursor, err := config.JobsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Errorf("Error querying JobsCollection: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var jobs []models.Job
+	if err := cursor.All(ctx, &jobs); err != nil {
+		log.Errorf("Error decoding jobs: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, "Error Decoding Results")
+	}
+
+	return jobs, nil
+}
+
+// QueryJobs returns a page of the Jobs matching the filter, newest first.
+func QueryJobs(ctx context.Context, filter bson.M, skip int64, limit int64) ([]models.Job, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "CreatedAt", Value: -1}}).SetSkip(skip).SetLimit(limit)
+
+	cursor, err := config.JobsCollection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Errorf("Error querying JobsCollection: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var jobs []models.Job
+	if err := cursor.All(ctx, &jobs); err != nil {
+		log.Errorf("Error decoding jobs: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, "Error Decoding Results")
+	}
+
+	return jobs, nil
+}
+
+// CountJobs returns the number of Jobs matching the filter.
+func CountJobs(ctx context.Context, filter bson.M) (int64, error) {
+	count, err := config.JobsCollection.CountDocuments(ctx, filter)
+	if err != nil {
+		log.Errorf("Error counting jobs: %v", err)
+		return 0, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return count, nil
+}
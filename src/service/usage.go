@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AddUsageRecord stores the usage of a finished job, a job already metered keeps its first record.
+func AddUsageRecord(ctx context.Context, record *models.UsageRecord) error {
+	if _, err := config.UsageCollection.InsertOne(ctx, record); err != nil && !mongo.IsDuplicateKeyError(err) {
+		log.Errorf("Error adding the usage of job %s: %v", record.JobId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	return nil
+}
+
+// AggregateUsage sums the usage records of the jobs finished in [from, to) per organization and billing period.
+// An empty tenantId aggregates all organizations.
+func AggregateUsage(ctx context.Context, tenantId string, from time.Time, to time.Time, period string) ([]models.Usage, error) {
+	match := bson.M{"FinishedAt": bson.M{"$gte": from, "$lt": to}}
+	if tenantId != "" {
+		match["TenantId"] = tenantId
+	}
+
+	format := "%Y-%m"
+	if period == models.UsagePeriodDay {
+		format = "%Y-%m-%d"
+	}
+
+	failed := bson.M{"$cond": bson.A{
+		bson.M{"$in": bson.A{"$Status", bson.A{models.JobStatusFailed, models.JobStatusCancelled}}}, 1, 0,
+	}}
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"TenantId": "$TenantId",
+				"Period":   bson.M{"$dateToString": bson.M{"format": format, "date": "$FinishedAt", "timezone": "UTC"}},
+			},
+			"Jobs":           bson.M{"$sum": 1},
+			"FailedJobs":     bson.M{"$sum": failed},
+			"QueueSeconds":   bson.M{"$sum": "$QueueSeconds"},
+			"ComputeSeconds": bson.M{"$sum": "$ComputeSeconds"},
+			"InputBytes":     bson.M{"$sum": "$InputBytes"},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":            0,
+			"TenantId":       "$_id.TenantId",
+			"Period":         "$_id.Period",
+			"Jobs":           1,
+			"FailedJobs":     1,
+			"QueueSeconds":   1,
+			"ComputeSeconds": 1,
+			"InputBytes":     1,
+		}}},
+	}
+
+	cursor, err := config.UsageCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		log.Errorf("Error aggregating usage: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var usages []models.Usage
+	if err := cursor.All(ctx, &usages); err != nil {
+		log.Errorf("Error decoding usage: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, "Error Decoding Results")
+	}
+
+	return usages, nil
+}
+
+// StartPoolUptime opens an uptime interval of the pool charged to the organization,
+// it returns false if the pool is already up.
+func StartPoolUptime(ctx context.Context, poolId string, tenantId string) (*models.PoolUptime, bool, error) {
+	now := time.Now()
+	uptime := &models.PoolUptime{
+		UptimeId:       poolId + "/" + strconv.FormatInt(now.UnixNano(), 10),
+		ResourcePoolId: poolId,
+		TenantId:       tenantId,
+		StartedAt:      now,
+	}
+
+	// The unique index on the open interval of a pool rejects a second start
+	if _, err := config.PoolUptimeCollection.InsertOne(ctx, uptime); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, false, nil
+		}
+		log.Errorf("Error starting the uptime of pool %s: %v", poolId, err)
+		return nil, false, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return uptime, true, nil
+}
+
+// StopPoolUptime closes the open uptime interval of the pool charged to the organization,
+// it returns nil if the pool is not up or was started by another organization.
+// An empty tenantId closes the interval of any organization.
+func StopPoolUptime(ctx context.Context, poolId string, tenantId string) (*models.PoolUptime, error) {
+	filter := bson.M{"ResourcePoolId": poolId, "StoppedAt": time.Time{}}
+	if tenantId != "" {
+		filter["TenantId"] = tenantId
+	}
+	update := bson.M{"$set": bson.M{"StoppedAt": time.Now()}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	var uptime models.PoolUptime
+	if err := config.PoolUptimeCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&uptime); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Errorf("Error stopping the uptime of pool %s: %v", poolId, err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &uptime, nil
+}
+
+// GetPoolUptimes returns the uptime intervals overlapping [from, to), open intervals included.
+// An empty tenantId returns the intervals of all organizations.
+func GetPoolUptimes(ctx context.Context, tenantId string, from time.Time, to time.Time) ([]models.PoolUptime, error) {
+	filter := bson.M{
+		"StartedAt": bson.M{"$lt": to},
+		"$or": bson.A{
+			bson.M{"StoppedAt": time.Time{}},
+			bson.M{"StoppedAt": bson.M{"$gt": from}},
+		},
+	}
+	if tenantId != "" {
+		filter["TenantId"] = tenantId
+	}
+
+	cursor, err := config.PoolUptimeCollection.Find(ctx, filter)
+	if err != nil {
+		log.Errorf("Error querying PoolUptimeCollection: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var uptimes []models.PoolUptime
+	if err := cursor.All(ctx, &uptimes); err != nil {
+		log.Errorf("Error decoding pool uptimes: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, "Error Decoding Results")
+	}
+
+	return uptimes, nil
+}
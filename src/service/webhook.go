@@ -0,0 +1,129 @@
+package service
+
+import (
+	"context"
+	"time"
+	"transform2/config"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SetWebhook registers or replaces the Webhook of the organization.
+func SetWebhook(ctx context.Context, webhook *models.Webhook) error {
+	update := bson.M{
+		"$set": bson.M{
+			"Url":       webhook.Url,
+			"Secret":    webhook.Secret,
+			"Disabled":  webhook.Disabled,
+			"UpdatedAt": webhook.UpdatedAt,
+		},
+		"$setOnInsert": bson.M{"CreatedAt": webhook.UpdatedAt},
+	}
+
+	_, err := config.WebhookCollection.UpdateOne(ctx, bson.M{"_id": webhook.TenantId}, update, options.Update().SetUpsert(true))
+	if err != nil {
+		log.Errorf("Error setting the webhook of tenant %s: %v", webhook.TenantId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// GetWebhook returns the Webhook of the organization, nil if it has none.
+func GetWebhook(ctx context.Context, tenantId string) (*models.Webhook, error) {
+	var webhook models.Webhook
+	if err := config.WebhookCollection.FindOne(ctx, bson.M{"_id": tenantId}).Decode(&webhook); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Errorf("Error getting the webhook of tenant %s: %v", tenantId, err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &webhook, nil
+}
+
+// RemoveWebhook removes the Webhook of the organization.
+func RemoveWebhook(ctx context.Context, tenantId string) error {
+	result, err := config.WebhookCollection.DeleteOne(ctx, bson.M{"_id": tenantId})
+	if err != nil {
+		log.Errorf("Error removing the webhook of tenant %s: %v", tenantId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	if result.DeletedCount == 0 {
+		return framework.NewServiceError(framework.ERR_SYS_PARAMETER, "No Webhook registered")
+	}
+
+	return nil
+}
+
+// AddWebhookDelivery stores a new delivery.
+func AddWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	if _, err := config.WebhookDeliveryCollection.InsertOne(ctx, delivery); err != nil {
+		log.Errorf("Error adding webhook delivery of job %s: %v", delivery.JobId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	return nil
+}
+
+// ClaimWebhookDelivery returns the pending delivery that is due longest and leases it until the
+// given time, so no other server instance attempts it meanwhile. It returns nil if none is due.
+func ClaimWebhookDelivery(ctx context.Context, leaseUntil time.Time) (*models.WebhookDelivery, error) {
+	filter := bson.M{"Status": models.WebhookDeliveryPending, "NextAttemptAt": bson.M{"$lte": time.Now()}}
+	update := bson.M{"$set": bson.M{"NextAttemptAt": leaseUntil}}
+	opts := options.FindOneAndUpdate().SetSort(bson.D{{Key: "NextAttemptAt", Value: 1}})
+
+	var delivery models.WebhookDelivery
+	if err := config.WebhookDeliveryCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&delivery); err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		log.Errorf("Error claiming a webhook delivery: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return &delivery, nil
+}
+
+// UpdateWebhookDelivery stores the result of a delivery attempt.
+func UpdateWebhookDelivery(ctx context.Context, delivery *models.WebhookDelivery) error {
+	update := bson.M{"$set": bson.M{
+		"Status":        delivery.Status,
+		"Attempts":      delivery.Attempts,
+		"ResponseCode":  delivery.ResponseCode,
+		"Error":         delivery.Error,
+		"NextAttemptAt": delivery.NextAttemptAt,
+		"DeliveredAt":   delivery.DeliveredAt,
+	}}
+
+	if _, err := config.WebhookDeliveryCollection.UpdateOne(ctx, bson.M{"_id": delivery.DeliveryId}, update); err != nil {
+		log.Errorf("Error updating webhook delivery %s: %v", delivery.DeliveryId, err)
+		return framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+
+	return nil
+}
+
+// QueryWebhookDeliveries returns the deliveries matching the filter, newest first.
+func QueryWebhookDeliveries(ctx context.Context, filter bson.M, skip int64, limit int64) ([]models.WebhookDelivery, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "CreatedAt", Value: -1}}).SetSkip(skip).SetLimit(limit)
+
+	cursor, err := config.WebhookDeliveryCollection.Find(ctx, filter, opts)
+	if err != nil {
+		log.Errorf("Error querying WebhookDeliveryCollection: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_DATABASE, err.Error())
+	}
+	defer cursor.Close(ctx)
+
+	var deliveries []models.WebhookDelivery
+	if err := cursor.All(ctx, &deliveries); err != nil {
+		log.Errorf("Error decoding webhook deliveries: %v", err)
+		return nil, framework.NewServiceError(framework.ERR_SYS_SERVER, "Error Decoding Results")
+	}
+
+	return deliveries, nil
+}
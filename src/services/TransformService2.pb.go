@@ -156,10 +156,14 @@ type JobType struct {
 	SystemSpecification int32  `protobuf:"varint,10,opt,name=SystemSpecification,proto3" json:"SystemSpecification,omitempty"` // 1 for POD, 2 for ECS
 	ImageUrl            string `protobuf:"bytes,20,opt,name=ImageUrl,proto3" json:"ImageUrl,omitempty"`                        // Docker image URL for POD, system image for ECS
 	// repeated string ExecutionScript = 7; // ZIP Format task execution script
-	ReScript        string   `protobuf:"bytes,30,opt,name=ReScript,proto3" json:"ReScript,omitempty"`               // Used to estimate the resources consumed by the task
-	ScScript        string   `protobuf:"bytes,40,opt,name=ScScript,proto3" json:"ScScript,omitempty"`               // Used to collect task status and progress from the output of the command line
-	JeScript        string   `protobuf:"bytes,50,opt,name=JeScript,proto3" json:"JeScript,omitempty"`               // Task entry command
-	FixedParameters []string `protobuf:"bytes,60,rep,name=FixedParameters,proto3" json:"FixedParameters,omitempty"` // Relevant parameters for the task
+	ReScript        string       `protobuf:"bytes,30,opt,name=ReScript,proto3" json:"ReScript,omitempty"`               // Used to estimate the resources consumed by the task
+	ScScript        string       `protobuf:"bytes,40,opt,name=ScScript,proto3" json:"ScScript,omitempty"`               // Used to collect task status and progress from the output of the command line
+	JeScript        string       `protobuf:"bytes,50,opt,name=JeScript,proto3" json:"JeScript,omitempty"`               // Task entry command
+	FixedParameters []string     `protobuf:"bytes,60,rep,name=FixedParameters,proto3" json:"FixedParameters,omitempty"` // Relevant parameters for the task
+	TaskQueue       string       `protobuf:"bytes,70,opt,name=TaskQueue,proto3" json:"TaskQueue,omitempty"`             // Temporal task queue the jobs of this type are dispatched to
+	Workflow        string       `protobuf:"bytes,80,opt,name=Workflow,proto3" json:"Workflow,omitempty"`               // Name of the workflow executing the jobs of this type
+	RetryPolicy     *RetryPolicy `protobuf:"bytes,90,opt,name=RetryPolicy,proto3" json:"RetryPolicy,omitempty"`         // Retries of the activities of the jobs, unset uses the Temporal defaults
+	Timeouts        *Timeouts    `protobuf:"bytes,100,opt,name=Timeouts,proto3" json:"Timeouts,omitempty"`              // Timeouts of the jobs and their activities, unset uses the defaults of the workflow
 }
 
 func (x *JobType) Reset() {
@@ -243,6 +247,202 @@ func (x *JobType) GetFixedParameters() []string {
 	return nil
 }
 
+func (x *JobType) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *JobType) GetWorkflow() string {
+	if x != nil {
+		return x.Workflow
+	}
+	return ""
+}
+
+func (x *JobType) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *JobType) GetTimeouts() *Timeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+// Timeouts of the jobs of a job type, 0 keeps the default of the workflow. The activity deadlines and
+// the job timeout grow by SecondsPerMB for every MB of the input of the job.
+type Timeouts struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ScheduleToStartSeconds int64   `protobuf:"varint,10,opt,name=ScheduleToStartSeconds,proto3" json:"ScheduleToStartSeconds,omitempty"` // Time a file may wait for a worker
+	StartToCloseSeconds    int64   `protobuf:"varint,20,opt,name=StartToCloseSeconds,proto3" json:"StartToCloseSeconds,omitempty"`       // Time an attempt of a file may take
+	ScheduleToCloseSeconds int64   `protobuf:"varint,30,opt,name=ScheduleToCloseSeconds,proto3" json:"ScheduleToCloseSeconds,omitempty"` // Time a file may take including its retries
+	HeartbeatSeconds       int64   `protobuf:"varint,40,opt,name=HeartbeatSeconds,proto3" json:"HeartbeatSeconds,omitempty"`             // Time an attempt may run without heartbeat
+	JobSeconds             int64   `protobuf:"varint,50,opt,name=JobSeconds,proto3" json:"JobSeconds,omitempty"`                         // Time the whole job may take, 0 uses the job_timeout of the service
+	SecondsPerMB           float64 `protobuf:"fixed64,60,opt,name=SecondsPerMB,proto3" json:"SecondsPerMB,omitempty"`                    // Seconds added to StartToClose, ScheduleToClose and the job timeout per MB of input
+}
+
+func (x *Timeouts) Reset() {
+	*x = Timeouts{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Timeouts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Timeouts) ProtoMessage() {}
+
+func (x *Timeouts) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Timeouts.ProtoReflect.Descriptor instead.
+func (*Timeouts) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Timeouts) GetScheduleToStartSeconds() int64 {
+	if x != nil {
+		return x.ScheduleToStartSeconds
+	}
+	return 0
+}
+
+func (x *Timeouts) GetStartToCloseSeconds() int64 {
+	if x != nil {
+		return x.StartToCloseSeconds
+	}
+	return 0
+}
+
+func (x *Timeouts) GetScheduleToCloseSeconds() int64 {
+	if x != nil {
+		return x.ScheduleToCloseSeconds
+	}
+	return 0
+}
+
+func (x *Timeouts) GetHeartbeatSeconds() int64 {
+	if x != nil {
+		return x.HeartbeatSeconds
+	}
+	return 0
+}
+
+func (x *Timeouts) GetJobSeconds() int64 {
+	if x != nil {
+		return x.JobSeconds
+	}
+	return 0
+}
+
+func (x *Timeouts) GetSecondsPerMB() float64 {
+	if x != nil {
+		return x.SecondsPerMB
+	}
+	return 0
+}
+
+type RetryPolicy struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MaxAttempts         int32    `protobuf:"varint,10,opt,name=MaxAttempts,proto3" json:"MaxAttempts,omitempty"`                // Maximum number of attempts, 0 is unlimited
+	InitialIntervalMs   int64    `protobuf:"varint,20,opt,name=InitialIntervalMs,proto3" json:"InitialIntervalMs,omitempty"`    // Backoff before the first retry in milliseconds
+	BackoffCoefficient  float64  `protobuf:"fixed64,30,opt,name=BackoffCoefficient,proto3" json:"BackoffCoefficient,omitempty"` // Factor the backoff grows with on every retry
+	MaximumIntervalMs   int64    `protobuf:"varint,40,opt,name=MaximumIntervalMs,proto3" json:"MaximumIntervalMs,omitempty"`    // Upper bound of the backoff in milliseconds
+	RetryableCategories []string `protobuf:"bytes,50,rep,name=RetryableCategories,proto3" json:"RetryableCategories,omitempty"` // Error categories that are retried, empty retries all
+}
+
+func (x *RetryPolicy) Reset() {
+	*x = RetryPolicy{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RetryPolicy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RetryPolicy) ProtoMessage() {}
+
+func (x *RetryPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RetryPolicy.ProtoReflect.Descriptor instead.
+func (*RetryPolicy) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RetryPolicy) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetInitialIntervalMs() int64 {
+	if x != nil {
+		return x.InitialIntervalMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetBackoffCoefficient() float64 {
+	if x != nil {
+		return x.BackoffCoefficient
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetMaximumIntervalMs() int64 {
+	if x != nil {
+		return x.MaximumIntervalMs
+	}
+	return 0
+}
+
+func (x *RetryPolicy) GetRetryableCategories() []string {
+	if x != nil {
+		return x.RetryableCategories
+	}
+	return nil
+}
+
 type C2S_QueryJobTypeRpnT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -255,7 +455,7 @@ type C2S_QueryJobTypeRpnT struct {
 func (x *C2S_QueryJobTypeRpnT) Reset() {
 	*x = C2S_QueryJobTypeRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[3]
+		mi := &file_TransformService2_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -268,7 +468,7 @@ func (x *C2S_QueryJobTypeRpnT) String() string {
 func (*C2S_QueryJobTypeRpnT) ProtoMessage() {}
 
 func (x *C2S_QueryJobTypeRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[3]
+	mi := &file_TransformService2_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -281,7 +481,7 @@ func (x *C2S_QueryJobTypeRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_QueryJobTypeRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_QueryJobTypeRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{3}
+	return file_TransformService2_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *C2S_QueryJobTypeRpnT) GetStatusCode() string {
@@ -318,7 +518,7 @@ type C2S_QueryJobTypeReqT struct {
 func (x *C2S_QueryJobTypeReqT) Reset() {
 	*x = C2S_QueryJobTypeReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[4]
+		mi := &file_TransformService2_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -331,7 +531,7 @@ func (x *C2S_QueryJobTypeReqT) String() string {
 func (*C2S_QueryJobTypeReqT) ProtoMessage() {}
 
 func (x *C2S_QueryJobTypeReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[4]
+	mi := &file_TransformService2_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -344,7 +544,7 @@ func (x *C2S_QueryJobTypeReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_QueryJobTypeReqT.ProtoReflect.Descriptor instead.
 func (*C2S_QueryJobTypeReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{4}
+	return file_TransformService2_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *C2S_QueryJobTypeReqT) GetJobTypeIdFilter() string {
@@ -437,7 +637,7 @@ type C2S_QueryJobSetReqT struct {
 func (x *C2S_QueryJobSetReqT) Reset() {
 	*x = C2S_QueryJobSetReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[5]
+		mi := &file_TransformService2_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -450,7 +650,7 @@ func (x *C2S_QueryJobSetReqT) String() string {
 func (*C2S_QueryJobSetReqT) ProtoMessage() {}
 
 func (x *C2S_QueryJobSetReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[5]
+	mi := &file_TransformService2_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -463,7 +663,7 @@ func (x *C2S_QueryJobSetReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_QueryJobSetReqT.ProtoReflect.Descriptor instead.
 func (*C2S_QueryJobSetReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{5}
+	return file_TransformService2_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *C2S_QueryJobSetReqT) GetJobSetIdFilter() string {
@@ -534,7 +734,7 @@ type C2S_QueryJobSetRpnT struct {
 func (x *C2S_QueryJobSetRpnT) Reset() {
 	*x = C2S_QueryJobSetRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[6]
+		mi := &file_TransformService2_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -547,7 +747,7 @@ func (x *C2S_QueryJobSetRpnT) String() string {
 func (*C2S_QueryJobSetRpnT) ProtoMessage() {}
 
 func (x *C2S_QueryJobSetRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[6]
+	mi := &file_TransformService2_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -560,7 +760,7 @@ func (x *C2S_QueryJobSetRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_QueryJobSetRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_QueryJobSetRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{6}
+	return file_TransformService2_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *C2S_QueryJobSetRpnT) GetCode() int64 {
@@ -592,7 +792,7 @@ type JobSet struct {
 func (x *JobSet) Reset() {
 	*x = JobSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[7]
+		mi := &file_TransformService2_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -605,7 +805,7 @@ func (x *JobSet) String() string {
 func (*JobSet) ProtoMessage() {}
 
 func (x *JobSet) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[7]
+	mi := &file_TransformService2_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -618,7 +818,7 @@ func (x *JobSet) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use JobSet.ProtoReflect.Descriptor instead.
 func (*JobSet) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{7}
+	return file_TransformService2_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *JobSet) GetJobSetId() string {
@@ -667,7 +867,7 @@ type C2S_GetJobSetReqT struct {
 func (x *C2S_GetJobSetReqT) Reset() {
 	*x = C2S_GetJobSetReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[8]
+		mi := &file_TransformService2_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -680,7 +880,7 @@ func (x *C2S_GetJobSetReqT) String() string {
 func (*C2S_GetJobSetReqT) ProtoMessage() {}
 
 func (x *C2S_GetJobSetReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[8]
+	mi := &file_TransformService2_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -693,7 +893,7 @@ func (x *C2S_GetJobSetReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_GetJobSetReqT.ProtoReflect.Descriptor instead.
 func (*C2S_GetJobSetReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{8}
+	return file_TransformService2_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *C2S_GetJobSetReqT) GetJobTypeId() string {
@@ -715,7 +915,7 @@ type C2S_GetJobSetRpnT struct {
 func (x *C2S_GetJobSetRpnT) Reset() {
 	*x = C2S_GetJobSetRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[9]
+		mi := &file_TransformService2_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +928,7 @@ func (x *C2S_GetJobSetRpnT) String() string {
 func (*C2S_GetJobSetRpnT) ProtoMessage() {}
 
 func (x *C2S_GetJobSetRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[9]
+	mi := &file_TransformService2_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +941,7 @@ func (x *C2S_GetJobSetRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_GetJobSetRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_GetJobSetRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{9}
+	return file_TransformService2_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *C2S_GetJobSetRpnT) GetCode() int64 {
@@ -770,7 +970,7 @@ type C2S_SetJobFixedArgumentsReqT struct {
 func (x *C2S_SetJobFixedArgumentsReqT) Reset() {
 	*x = C2S_SetJobFixedArgumentsReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[10]
+		mi := &file_TransformService2_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -783,7 +983,7 @@ func (x *C2S_SetJobFixedArgumentsReqT) String() string {
 func (*C2S_SetJobFixedArgumentsReqT) ProtoMessage() {}
 
 func (x *C2S_SetJobFixedArgumentsReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[10]
+	mi := &file_TransformService2_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -796,7 +996,7 @@ func (x *C2S_SetJobFixedArgumentsReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_SetJobFixedArgumentsReqT.ProtoReflect.Descriptor instead.
 func (*C2S_SetJobFixedArgumentsReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{10}
+	return file_TransformService2_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *C2S_SetJobFixedArgumentsReqT) GetID() string {
@@ -825,7 +1025,7 @@ type C2S_SetJobFixedArgumentsRpnT struct {
 func (x *C2S_SetJobFixedArgumentsRpnT) Reset() {
 	*x = C2S_SetJobFixedArgumentsRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[11]
+		mi := &file_TransformService2_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -838,7 +1038,7 @@ func (x *C2S_SetJobFixedArgumentsRpnT) String() string {
 func (*C2S_SetJobFixedArgumentsRpnT) ProtoMessage() {}
 
 func (x *C2S_SetJobFixedArgumentsRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[11]
+	mi := &file_TransformService2_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -851,7 +1051,7 @@ func (x *C2S_SetJobFixedArgumentsRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_SetJobFixedArgumentsRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_SetJobFixedArgumentsRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{11}
+	return file_TransformService2_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *C2S_SetJobFixedArgumentsRpnT) GetCode() int64 {
@@ -882,7 +1082,7 @@ type C2S_AddJobSetReqT struct {
 func (x *C2S_AddJobSetReqT) Reset() {
 	*x = C2S_AddJobSetReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[12]
+		mi := &file_TransformService2_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -895,7 +1095,7 @@ func (x *C2S_AddJobSetReqT) String() string {
 func (*C2S_AddJobSetReqT) ProtoMessage() {}
 
 func (x *C2S_AddJobSetReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[12]
+	mi := &file_TransformService2_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -908,7 +1108,7 @@ func (x *C2S_AddJobSetReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_AddJobSetReqT.ProtoReflect.Descriptor instead.
 func (*C2S_AddJobSetReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{12}
+	return file_TransformService2_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *C2S_AddJobSetReqT) GetJobTypeId() string {
@@ -954,7 +1154,7 @@ type C2S_SetJobSetReqT struct {
 func (x *C2S_SetJobSetReqT) Reset() {
 	*x = C2S_SetJobSetReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[13]
+		mi := &file_TransformService2_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -967,7 +1167,7 @@ func (x *C2S_SetJobSetReqT) String() string {
 func (*C2S_SetJobSetReqT) ProtoMessage() {}
 
 func (x *C2S_SetJobSetReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[13]
+	mi := &file_TransformService2_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,7 +1180,7 @@ func (x *C2S_SetJobSetReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_SetJobSetReqT.ProtoReflect.Descriptor instead.
 func (*C2S_SetJobSetReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{13}
+	return file_TransformService2_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *C2S_SetJobSetReqT) GetJobSetId() string {
@@ -1029,7 +1229,7 @@ type C2S_RemoveJobSetReqT struct {
 func (x *C2S_RemoveJobSetReqT) Reset() {
 	*x = C2S_RemoveJobSetReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[14]
+		mi := &file_TransformService2_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1042,7 +1242,7 @@ func (x *C2S_RemoveJobSetReqT) String() string {
 func (*C2S_RemoveJobSetReqT) ProtoMessage() {}
 
 func (x *C2S_RemoveJobSetReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[14]
+	mi := &file_TransformService2_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1055,7 +1255,7 @@ func (x *C2S_RemoveJobSetReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_RemoveJobSetReqT.ProtoReflect.Descriptor instead.
 func (*C2S_RemoveJobSetReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{14}
+	return file_TransformService2_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *C2S_RemoveJobSetReqT) GetJobSetId() string {
@@ -1077,7 +1277,7 @@ type C2S_RemoveJobSetRpnT struct {
 func (x *C2S_RemoveJobSetRpnT) Reset() {
 	*x = C2S_RemoveJobSetRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[15]
+		mi := &file_TransformService2_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1090,7 +1290,7 @@ func (x *C2S_RemoveJobSetRpnT) String() string {
 func (*C2S_RemoveJobSetRpnT) ProtoMessage() {}
 
 func (x *C2S_RemoveJobSetRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[15]
+	mi := &file_TransformService2_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1103,7 +1303,7 @@ func (x *C2S_RemoveJobSetRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_RemoveJobSetRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_RemoveJobSetRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{15}
+	return file_TransformService2_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *C2S_RemoveJobSetRpnT) GetCode() int64 {
@@ -1133,7 +1333,7 @@ type C2S_SetJobSetRpnT struct {
 func (x *C2S_SetJobSetRpnT) Reset() {
 	*x = C2S_SetJobSetRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[16]
+		mi := &file_TransformService2_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1146,7 +1346,7 @@ func (x *C2S_SetJobSetRpnT) String() string {
 func (*C2S_SetJobSetRpnT) ProtoMessage() {}
 
 func (x *C2S_SetJobSetRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[16]
+	mi := &file_TransformService2_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1159,7 +1359,7 @@ func (x *C2S_SetJobSetRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_SetJobSetRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_SetJobSetRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{16}
+	return file_TransformService2_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *C2S_SetJobSetRpnT) GetJobSetId() string {
@@ -1196,7 +1396,7 @@ type C2S_AddJobSetRpnT struct {
 func (x *C2S_AddJobSetRpnT) Reset() {
 	*x = C2S_AddJobSetRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[17]
+		mi := &file_TransformService2_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1209,7 +1409,7 @@ func (x *C2S_AddJobSetRpnT) String() string {
 func (*C2S_AddJobSetRpnT) ProtoMessage() {}
 
 func (x *C2S_AddJobSetRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[17]
+	mi := &file_TransformService2_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1222,7 +1422,7 @@ func (x *C2S_AddJobSetRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_AddJobSetRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_AddJobSetRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{17}
+	return file_TransformService2_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *C2S_AddJobSetRpnT) GetJobSetId() string {
@@ -1258,7 +1458,7 @@ type C2S_GetResourcePoolReqT struct {
 func (x *C2S_GetResourcePoolReqT) Reset() {
 	*x = C2S_GetResourcePoolReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[18]
+		mi := &file_TransformService2_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1271,7 +1471,7 @@ func (x *C2S_GetResourcePoolReqT) String() string {
 func (*C2S_GetResourcePoolReqT) ProtoMessage() {}
 
 func (x *C2S_GetResourcePoolReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[18]
+	mi := &file_TransformService2_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1284,7 +1484,7 @@ func (x *C2S_GetResourcePoolReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_GetResourcePoolReqT.ProtoReflect.Descriptor instead.
 func (*C2S_GetResourcePoolReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{18}
+	return file_TransformService2_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *C2S_GetResourcePoolReqT) GetPoolId() string {
@@ -1314,7 +1514,7 @@ type C2S_GetResourcePoolRpnT struct {
 func (x *C2S_GetResourcePoolRpnT) Reset() {
 	*x = C2S_GetResourcePoolRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[19]
+		mi := &file_TransformService2_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1327,7 +1527,7 @@ func (x *C2S_GetResourcePoolRpnT) String() string {
 func (*C2S_GetResourcePoolRpnT) ProtoMessage() {}
 
 func (x *C2S_GetResourcePoolRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[19]
+	mi := &file_TransformService2_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1340,7 +1540,7 @@ func (x *C2S_GetResourcePoolRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_GetResourcePoolRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_GetResourcePoolRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{19}
+	return file_TransformService2_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *C2S_GetResourcePoolRpnT) GetPool() *ResourcePool {
@@ -1390,7 +1590,7 @@ type C2S_QueryResourcePoolReqT struct {
 func (x *C2S_QueryResourcePoolReqT) Reset() {
 	*x = C2S_QueryResourcePoolReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[20]
+		mi := &file_TransformService2_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1403,7 +1603,7 @@ func (x *C2S_QueryResourcePoolReqT) String() string {
 func (*C2S_QueryResourcePoolReqT) ProtoMessage() {}
 
 func (x *C2S_QueryResourcePoolReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[20]
+	mi := &file_TransformService2_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1416,7 +1616,7 @@ func (x *C2S_QueryResourcePoolReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_QueryResourcePoolReqT.ProtoReflect.Descriptor instead.
 func (*C2S_QueryResourcePoolReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{20}
+	return file_TransformService2_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *C2S_QueryResourcePoolReqT) GetResourcePoolIdFilter() string {
@@ -1504,7 +1704,7 @@ type C2S_QueryResourcePoolRpnT struct {
 func (x *C2S_QueryResourcePoolRpnT) Reset() {
 	*x = C2S_QueryResourcePoolRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[21]
+		mi := &file_TransformService2_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1517,7 +1717,7 @@ func (x *C2S_QueryResourcePoolRpnT) String() string {
 func (*C2S_QueryResourcePoolRpnT) ProtoMessage() {}
 
 func (x *C2S_QueryResourcePoolRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[21]
+	mi := &file_TransformService2_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,7 +1730,7 @@ func (x *C2S_QueryResourcePoolRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_QueryResourcePoolRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_QueryResourcePoolRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{21}
+	return file_TransformService2_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *C2S_QueryResourcePoolRpnT) GetCode() string {
@@ -1570,12 +1770,14 @@ type ResourcePool struct {
 	Fixed           int32                           `protobuf:"varint,80,opt,name=Fixed,proto3" json:"Fixed,omitempty"`
 	DefaultTaskSet  int32                           `protobuf:"varint,90,opt,name=DefaultTaskSet,proto3" json:"DefaultTaskSet,omitempty"`
 	ResourceLimits  map[string]*ResourceLimitOfTask `protobuf:"bytes,100,rep,name=ResourceLimits,proto3" json:"ResourceLimits,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SlotCpu         float64                         `protobuf:"fixed64,110,opt,name=SlotCpu,proto3" json:"SlotCpu,omitempty"`          // CPU cores of a slot, a job needing more takes several slots
+	SlotMemoryMB    int64                           `protobuf:"varint,120,opt,name=SlotMemoryMB,proto3" json:"SlotMemoryMB,omitempty"` // Memory of a slot in megabytes, a job needing more takes several slots
 }
 
 func (x *ResourcePool) Reset() {
 	*x = ResourcePool{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[22]
+		mi := &file_TransformService2_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1588,7 +1790,7 @@ func (x *ResourcePool) String() string {
 func (*ResourcePool) ProtoMessage() {}
 
 func (x *ResourcePool) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[22]
+	mi := &file_TransformService2_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1601,7 +1803,7 @@ func (x *ResourcePool) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourcePool.ProtoReflect.Descriptor instead.
 func (*ResourcePool) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{22}
+	return file_TransformService2_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ResourcePool) GetResourcePoolId() string {
@@ -1674,6 +1876,20 @@ func (x *ResourcePool) GetResourceLimits() map[string]*ResourceLimitOfTask {
 	return nil
 }
 
+func (x *ResourcePool) GetSlotCpu() float64 {
+	if x != nil {
+		return x.SlotCpu
+	}
+	return 0
+}
+
+func (x *ResourcePool) GetSlotMemoryMB() int64 {
+	if x != nil {
+		return x.SlotMemoryMB
+	}
+	return 0
+}
+
 // Definition of resource limits for a specific task type
 type ResourceLimitOfTask struct {
 	state         protoimpl.MessageState
@@ -1687,7 +1903,7 @@ type ResourceLimitOfTask struct {
 func (x *ResourceLimitOfTask) Reset() {
 	*x = ResourceLimitOfTask{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[23]
+		mi := &file_TransformService2_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1700,7 +1916,7 @@ func (x *ResourceLimitOfTask) String() string {
 func (*ResourceLimitOfTask) ProtoMessage() {}
 
 func (x *ResourceLimitOfTask) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[23]
+	mi := &file_TransformService2_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1713,7 +1929,7 @@ func (x *ResourceLimitOfTask) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceLimitOfTask.ProtoReflect.Descriptor instead.
 func (*ResourceLimitOfTask) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{23}
+	return file_TransformService2_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ResourceLimitOfTask) GetJobTypeId() string {
@@ -1746,12 +1962,14 @@ type C2S_AddResourcePoolReqT struct {
 	Fixed           int32                  `protobuf:"varint,60,opt,name=Fixed,proto3" json:"Fixed,omitempty"`                     // Number of reserved machines
 	DefaultTaskSet  int32                  `protobuf:"varint,70,opt,name=DefaultTaskSet,proto3" json:"DefaultTaskSet,omitempty"`   // Default Task Set
 	ResourceLimit   []*ResourceLimitOfTask `protobuf:"bytes,80,rep,name=ResourceLimit,proto3" json:"ResourceLimit,omitempty"`      // Limits on the number of available machines for each task type
+	SlotCpu         float64                `protobuf:"fixed64,90,opt,name=SlotCpu,proto3" json:"SlotCpu,omitempty"`                // CPU cores of a slot, 0 does not limit the CPU of the jobs
+	SlotMemoryMB    int64                  `protobuf:"varint,100,opt,name=SlotMemoryMB,proto3" json:"SlotMemoryMB,omitempty"`      // Memory of a slot in megabytes, 0 does not limit the memory of the jobs
 }
 
 func (x *C2S_AddResourcePoolReqT) Reset() {
 	*x = C2S_AddResourcePoolReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[24]
+		mi := &file_TransformService2_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1764,7 +1982,7 @@ func (x *C2S_AddResourcePoolReqT) String() string {
 func (*C2S_AddResourcePoolReqT) ProtoMessage() {}
 
 func (x *C2S_AddResourcePoolReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[24]
+	mi := &file_TransformService2_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1777,7 +1995,7 @@ func (x *C2S_AddResourcePoolReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_AddResourcePoolReqT.ProtoReflect.Descriptor instead.
 func (*C2S_AddResourcePoolReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{24}
+	return file_TransformService2_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *C2S_AddResourcePoolReqT) GetName() string {
@@ -1843,6 +2061,20 @@ func (x *C2S_AddResourcePoolReqT) GetResourceLimit() []*ResourceLimitOfTask {
 	return nil
 }
 
+func (x *C2S_AddResourcePoolReqT) GetSlotCpu() float64 {
+	if x != nil {
+		return x.SlotCpu
+	}
+	return 0
+}
+
+func (x *C2S_AddResourcePoolReqT) GetSlotMemoryMB() int64 {
+	if x != nil {
+		return x.SlotMemoryMB
+	}
+	return 0
+}
+
 // Response parameters for adding a new resource pool
 type C2S_AddResourcePoolRpnT struct {
 	state         protoimpl.MessageState
@@ -1858,7 +2090,7 @@ type C2S_AddResourcePoolRpnT struct {
 func (x *C2S_AddResourcePoolRpnT) Reset() {
 	*x = C2S_AddResourcePoolRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[25]
+		mi := &file_TransformService2_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1871,7 +2103,7 @@ func (x *C2S_AddResourcePoolRpnT) String() string {
 func (*C2S_AddResourcePoolRpnT) ProtoMessage() {}
 
 func (x *C2S_AddResourcePoolRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[25]
+	mi := &file_TransformService2_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1884,7 +2116,7 @@ func (x *C2S_AddResourcePoolRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_AddResourcePoolRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_AddResourcePoolRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{25}
+	return file_TransformService2_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *C2S_AddResourcePoolRpnT) GetResourcePoolId() string {
@@ -1927,7 +2159,7 @@ type C2S_RemoveResourcePoolReqT struct {
 func (x *C2S_RemoveResourcePoolReqT) Reset() {
 	*x = C2S_RemoveResourcePoolReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[26]
+		mi := &file_TransformService2_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1940,7 +2172,7 @@ func (x *C2S_RemoveResourcePoolReqT) String() string {
 func (*C2S_RemoveResourcePoolReqT) ProtoMessage() {}
 
 func (x *C2S_RemoveResourcePoolReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[26]
+	mi := &file_TransformService2_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1953,7 +2185,7 @@ func (x *C2S_RemoveResourcePoolReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_RemoveResourcePoolReqT.ProtoReflect.Descriptor instead.
 func (*C2S_RemoveResourcePoolReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{26}
+	return file_TransformService2_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *C2S_RemoveResourcePoolReqT) GetPoolId() string {
@@ -1976,7 +2208,7 @@ type C2S_RemoveResourcePoolRpnT struct {
 func (x *C2S_RemoveResourcePoolRpnT) Reset() {
 	*x = C2S_RemoveResourcePoolRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[27]
+		mi := &file_TransformService2_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1989,7 +2221,7 @@ func (x *C2S_RemoveResourcePoolRpnT) String() string {
 func (*C2S_RemoveResourcePoolRpnT) ProtoMessage() {}
 
 func (x *C2S_RemoveResourcePoolRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[27]
+	mi := &file_TransformService2_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2002,7 +2234,7 @@ func (x *C2S_RemoveResourcePoolRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_RemoveResourcePoolRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_RemoveResourcePoolRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{27}
+	return file_TransformService2_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *C2S_RemoveResourcePoolRpnT) GetStatusCode() string {
@@ -2036,12 +2268,14 @@ type C2S_SetResourcePoolReqT struct {
 	Fixed           int32                  `protobuf:"varint,70,opt,name=Fixed,proto3" json:"Fixed,omitempty"`                     // Number of reserved machines
 	DefaultTaskSet  int32                  `protobuf:"varint,80,opt,name=DefaultTaskSet,proto3" json:"DefaultTaskSet,omitempty"`   // Default Task Set
 	ResourceLimit   []*ResourceLimitOfTask `protobuf:"bytes,90,rep,name=ResourceLimit,proto3" json:"ResourceLimit,omitempty"`      // Limits on the number of available machines for each task type
+	SlotCpu         float64                `protobuf:"fixed64,100,opt,name=SlotCpu,proto3" json:"SlotCpu,omitempty"`               // CPU cores of a slot, 0 does not limit the CPU of the jobs
+	SlotMemoryMB    int64                  `protobuf:"varint,110,opt,name=SlotMemoryMB,proto3" json:"SlotMemoryMB,omitempty"`      // Memory of a slot in megabytes, 0 does not limit the memory of the jobs
 }
 
 func (x *C2S_SetResourcePoolReqT) Reset() {
 	*x = C2S_SetResourcePoolReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[28]
+		mi := &file_TransformService2_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2054,7 +2288,7 @@ func (x *C2S_SetResourcePoolReqT) String() string {
 func (*C2S_SetResourcePoolReqT) ProtoMessage() {}
 
 func (x *C2S_SetResourcePoolReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[28]
+	mi := &file_TransformService2_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2067,7 +2301,7 @@ func (x *C2S_SetResourcePoolReqT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_SetResourcePoolReqT.ProtoReflect.Descriptor instead.
 func (*C2S_SetResourcePoolReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{28}
+	return file_TransformService2_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *C2S_SetResourcePoolReqT) GetResourcePoolId() string {
@@ -2140,6 +2374,20 @@ func (x *C2S_SetResourcePoolReqT) GetResourceLimit() []*ResourceLimitOfTask {
 	return nil
 }
 
+func (x *C2S_SetResourcePoolReqT) GetSlotCpu() float64 {
+	if x != nil {
+		return x.SlotCpu
+	}
+	return 0
+}
+
+func (x *C2S_SetResourcePoolReqT) GetSlotMemoryMB() int64 {
+	if x != nil {
+		return x.SlotMemoryMB
+	}
+	return 0
+}
+
 // Response parameters for configuring or updating an existing resource pool
 type C2S_SetResourcePoolRpnT struct {
 	state         protoimpl.MessageState
@@ -2154,7 +2402,7 @@ type C2S_SetResourcePoolRpnT struct {
 func (x *C2S_SetResourcePoolRpnT) Reset() {
 	*x = C2S_SetResourcePoolRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[29]
+		mi := &file_TransformService2_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2167,7 +2415,7 @@ func (x *C2S_SetResourcePoolRpnT) String() string {
 func (*C2S_SetResourcePoolRpnT) ProtoMessage() {}
 
 func (x *C2S_SetResourcePoolRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[29]
+	mi := &file_TransformService2_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2180,7 +2428,7 @@ func (x *C2S_SetResourcePoolRpnT) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use C2S_SetResourcePoolRpnT.ProtoReflect.Descriptor instead.
 func (*C2S_SetResourcePoolRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{29}
+	return file_TransformService2_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *C2S_SetResourcePoolRpnT) GetResourcePoolId() string {
@@ -2204,38 +2452,32 @@ func (x *C2S_SetResourcePoolRpnT) GetMessage() string {
 	return ""
 }
 
-// Request Parameters for Adding a Job Type
-type C2S_AddJobTypeReqT struct {
+// Request parameters for starting an exclusive resource pool, the organization of the caller is charged for its uptime
+type C2S_StartResourcePoolReqT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SystemSpecification int32  `protobuf:"varint,10,opt,name=SystemSpecification,proto3" json:"SystemSpecification,omitempty"` // 1 for POD, 2 for ECS
-	ImageUrl            string `protobuf:"bytes,20,opt,name=ImageUrl,proto3" json:"ImageUrl,omitempty"`                        // Docker image URL for POD, system image for ECS
-	// repeated string ExecutionScript = 7; // ZIP Format task execution script
-	ReScript        string   `protobuf:"bytes,30,opt,name=ReScript,proto3" json:"ReScript,omitempty"`               // Used to estimate the resources consumed by the task
-	ScScript        string   `protobuf:"bytes,40,opt,name=ScScript,proto3" json:"ScScript,omitempty"`               // Used to collect task status and progress from the output of the command line
-	JeScript        string   `protobuf:"bytes,50,opt,name=JeScript,proto3" json:"JeScript,omitempty"`               // Task entry command
-	FixedParameters []string `protobuf:"bytes,60,rep,name=FixedParameters,proto3" json:"FixedParameters,omitempty"` // Relevant parameters for the task
+	PoolId string `protobuf:"bytes,10,opt,name=PoolId,proto3" json:"PoolId,omitempty"` // Identifier of the resource pool to be started
 }
 
-func (x *C2S_AddJobTypeReqT) Reset() {
-	*x = C2S_AddJobTypeReqT{}
+func (x *C2S_StartResourcePoolReqT) Reset() {
+	*x = C2S_StartResourcePoolReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[30]
+		mi := &file_TransformService2_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *C2S_AddJobTypeReqT) String() string {
+func (x *C2S_StartResourcePoolReqT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2S_AddJobTypeReqT) ProtoMessage() {}
+func (*C2S_StartResourcePoolReqT) ProtoMessage() {}
 
-func (x *C2S_AddJobTypeReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[30]
+func (x *C2S_StartResourcePoolReqT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2246,79 +2488,108 @@ func (x *C2S_AddJobTypeReqT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2S_AddJobTypeReqT.ProtoReflect.Descriptor instead.
-func (*C2S_AddJobTypeReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use C2S_StartResourcePoolReqT.ProtoReflect.Descriptor instead.
+func (*C2S_StartResourcePoolReqT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *C2S_AddJobTypeReqT) GetSystemSpecification() int32 {
+func (x *C2S_StartResourcePoolReqT) GetPoolId() string {
 	if x != nil {
-		return x.SystemSpecification
+		return x.PoolId
 	}
-	return 0
+	return ""
 }
 
-func (x *C2S_AddJobTypeReqT) GetImageUrl() string {
-	if x != nil {
-		return x.ImageUrl
+// Response parameters for starting an exclusive resource pool
+type C2S_StartResourcePoolRpnT struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode string `protobuf:"bytes,20,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service execution status
+	Message    string `protobuf:"bytes,30,opt,name=Message,proto3" json:"Message,omitempty"`       // Message from the service after execution
+	StartedAt  string `protobuf:"bytes,40,opt,name=StartedAt,proto3" json:"StartedAt,omitempty"`   // Time when the pool was started (RFC 3339)
+}
+
+func (x *C2S_StartResourcePoolRpnT) Reset() {
+	*x = C2S_StartResourcePoolRpnT{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *C2S_AddJobTypeReqT) GetReScript() string {
-	if x != nil {
-		return x.ReScript
+func (x *C2S_StartResourcePoolRpnT) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_StartResourcePoolRpnT) ProtoMessage() {}
+
+func (x *C2S_StartResourcePoolRpnT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *C2S_AddJobTypeReqT) GetScScript() string {
+// Deprecated: Use C2S_StartResourcePoolRpnT.ProtoReflect.Descriptor instead.
+func (*C2S_StartResourcePoolRpnT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *C2S_StartResourcePoolRpnT) GetStatusCode() string {
 	if x != nil {
-		return x.ScScript
+		return x.StatusCode
 	}
 	return ""
 }
 
-func (x *C2S_AddJobTypeReqT) GetJeScript() string {
+func (x *C2S_StartResourcePoolRpnT) GetMessage() string {
 	if x != nil {
-		return x.JeScript
+		return x.Message
 	}
 	return ""
 }
 
-func (x *C2S_AddJobTypeReqT) GetFixedParameters() []string {
+func (x *C2S_StartResourcePoolRpnT) GetStartedAt() string {
 	if x != nil {
-		return x.FixedParameters
+		return x.StartedAt
 	}
-	return nil
+	return ""
 }
 
-// Response Parameters for Adding a Job Type
-type C2S_GetJobTypeReqT struct {
+// Request parameters for stopping an exclusive resource pool
+type C2S_StopResourcePoolReqT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobTypeId string `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"` // ID of the task added
+	PoolId string `protobuf:"bytes,10,opt,name=PoolId,proto3" json:"PoolId,omitempty"` // Identifier of the resource pool to be stopped
 }
 
-func (x *C2S_GetJobTypeReqT) Reset() {
-	*x = C2S_GetJobTypeReqT{}
+func (x *C2S_StopResourcePoolReqT) Reset() {
+	*x = C2S_StopResourcePoolReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[31]
+		mi := &file_TransformService2_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *C2S_GetJobTypeReqT) String() string {
+func (x *C2S_StopResourcePoolReqT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2S_GetJobTypeReqT) ProtoMessage() {}
+func (*C2S_StopResourcePoolReqT) ProtoMessage() {}
 
-func (x *C2S_GetJobTypeReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[31]
+func (x *C2S_StopResourcePoolReqT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2329,45 +2600,46 @@ func (x *C2S_GetJobTypeReqT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2S_GetJobTypeReqT.ProtoReflect.Descriptor instead.
-func (*C2S_GetJobTypeReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use C2S_StopResourcePoolReqT.ProtoReflect.Descriptor instead.
+func (*C2S_StopResourcePoolReqT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *C2S_GetJobTypeReqT) GetJobTypeId() string {
+func (x *C2S_StopResourcePoolReqT) GetPoolId() string {
 	if x != nil {
-		return x.JobTypeId
+		return x.PoolId
 	}
 	return ""
 }
 
-type C2S_GetJobTypeRpnT struct {
+// Response parameters for stopping an exclusive resource pool
+type C2S_StopResourcePoolRpnT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobType    *JobType `protobuf:"bytes,10,opt,name=JobType,proto3" json:"JobType,omitempty"`
-	StatusCode string   `protobuf:"bytes,20,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"`
-	Message    string   `protobuf:"bytes,30,opt,name=Message,proto3" json:"Message,omitempty"`
+	StatusCode    string `protobuf:"bytes,20,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"`        // Code denoting the service execution status
+	Message       string `protobuf:"bytes,30,opt,name=Message,proto3" json:"Message,omitempty"`              // Message from the service after execution
+	UptimeSeconds int64  `protobuf:"varint,40,opt,name=UptimeSeconds,proto3" json:"UptimeSeconds,omitempty"` // Time the pool was up since it was started
 }
 
-func (x *C2S_GetJobTypeRpnT) Reset() {
-	*x = C2S_GetJobTypeRpnT{}
+func (x *C2S_StopResourcePoolRpnT) Reset() {
+	*x = C2S_StopResourcePoolRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[32]
+		mi := &file_TransformService2_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *C2S_GetJobTypeRpnT) String() string {
+func (x *C2S_StopResourcePoolRpnT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2S_GetJobTypeRpnT) ProtoMessage() {}
+func (*C2S_StopResourcePoolRpnT) ProtoMessage() {}
 
-func (x *C2S_GetJobTypeRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[32]
+func (x *C2S_StopResourcePoolRpnT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2378,60 +2650,68 @@ func (x *C2S_GetJobTypeRpnT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2S_GetJobTypeRpnT.ProtoReflect.Descriptor instead.
-func (*C2S_GetJobTypeRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use C2S_StopResourcePoolRpnT.ProtoReflect.Descriptor instead.
+func (*C2S_StopResourcePoolRpnT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *C2S_GetJobTypeRpnT) GetJobType() *JobType {
+func (x *C2S_StopResourcePoolRpnT) GetStatusCode() string {
 	if x != nil {
-		return x.JobType
+		return x.StatusCode
 	}
-	return nil
+	return ""
 }
 
-func (x *C2S_GetJobTypeRpnT) GetStatusCode() string {
+func (x *C2S_StopResourcePoolRpnT) GetMessage() string {
 	if x != nil {
-		return x.StatusCode
+		return x.Message
 	}
 	return ""
 }
 
-func (x *C2S_GetJobTypeRpnT) GetMessage() string {
+func (x *C2S_StopResourcePoolRpnT) GetUptimeSeconds() int64 {
 	if x != nil {
-		return x.Message
+		return x.UptimeSeconds
 	}
-	return ""
+	return 0
 }
 
-// Response Parameters for Adding a Job Type
-type S2C_AddJobTypeRpnT struct {
+// Request Parameters for Adding a Job Type
+type C2S_AddJobTypeReqT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobTypeId  string `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"`   // ID of the task added
-	StatusCode string `protobuf:"bytes,20,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service execution
-	Message    string `protobuf:"bytes,30,opt,name=Message,proto3" json:"Message,omitempty"`       // Message from the service after execution
+	SystemSpecification int32  `protobuf:"varint,10,opt,name=SystemSpecification,proto3" json:"SystemSpecification,omitempty"` // 1 for POD, 2 for ECS
+	ImageUrl            string `protobuf:"bytes,20,opt,name=ImageUrl,proto3" json:"ImageUrl,omitempty"`                        // Docker image URL for POD, system image for ECS
+	// repeated string ExecutionScript = 7; // ZIP Format task execution script
+	ReScript        string       `protobuf:"bytes,30,opt,name=ReScript,proto3" json:"ReScript,omitempty"`               // Used to estimate the resources consumed by the task
+	ScScript        string       `protobuf:"bytes,40,opt,name=ScScript,proto3" json:"ScScript,omitempty"`               // Used to collect task status and progress from the output of the command line
+	JeScript        string       `protobuf:"bytes,50,opt,name=JeScript,proto3" json:"JeScript,omitempty"`               // Task entry command
+	FixedParameters []string     `protobuf:"bytes,60,rep,name=FixedParameters,proto3" json:"FixedParameters,omitempty"` // Relevant parameters for the task
+	TaskQueue       string       `protobuf:"bytes,70,opt,name=TaskQueue,proto3" json:"TaskQueue,omitempty"`             // Temporal task queue the jobs of this type are dispatched to
+	Workflow        string       `protobuf:"bytes,80,opt,name=Workflow,proto3" json:"Workflow,omitempty"`               // Name of the workflow executing the jobs of this type
+	RetryPolicy     *RetryPolicy `protobuf:"bytes,90,opt,name=RetryPolicy,proto3" json:"RetryPolicy,omitempty"`         // Retries of the activities of the jobs, unset uses the Temporal defaults
+	Timeouts        *Timeouts    `protobuf:"bytes,100,opt,name=Timeouts,proto3" json:"Timeouts,omitempty"`              // Timeouts of the jobs and their activities, unset uses the defaults of the workflow
 }
 
-func (x *S2C_AddJobTypeRpnT) Reset() {
-	*x = S2C_AddJobTypeRpnT{}
+func (x *C2S_AddJobTypeReqT) Reset() {
+	*x = C2S_AddJobTypeReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[33]
+		mi := &file_TransformService2_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *S2C_AddJobTypeRpnT) String() string {
+func (x *C2S_AddJobTypeReqT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_AddJobTypeRpnT) ProtoMessage() {}
+func (*C2S_AddJobTypeReqT) ProtoMessage() {}
 
-func (x *S2C_AddJobTypeRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[33]
+func (x *C2S_AddJobTypeReqT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2442,107 +2722,107 @@ func (x *S2C_AddJobTypeRpnT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_AddJobTypeRpnT.ProtoReflect.Descriptor instead.
-func (*S2C_AddJobTypeRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use C2S_AddJobTypeReqT.ProtoReflect.Descriptor instead.
+func (*C2S_AddJobTypeReqT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *S2C_AddJobTypeRpnT) GetJobTypeId() string {
+func (x *C2S_AddJobTypeReqT) GetSystemSpecification() int32 {
 	if x != nil {
-		return x.JobTypeId
+		return x.SystemSpecification
 	}
-	return ""
+	return 0
 }
 
-func (x *S2C_AddJobTypeRpnT) GetStatusCode() string {
+func (x *C2S_AddJobTypeReqT) GetImageUrl() string {
 	if x != nil {
-		return x.StatusCode
+		return x.ImageUrl
 	}
 	return ""
 }
 
-func (x *S2C_AddJobTypeRpnT) GetMessage() string {
+func (x *C2S_AddJobTypeReqT) GetReScript() string {
 	if x != nil {
-		return x.Message
+		return x.ReScript
 	}
 	return ""
 }
 
-// Request Parameters for Removing a Job Type
-type C2S_RemoveJobTypeReqT struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	JobTypeId string `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"` // ID of the job to be removed
+func (x *C2S_AddJobTypeReqT) GetScScript() string {
+	if x != nil {
+		return x.ScScript
+	}
+	return ""
 }
 
-func (x *C2S_RemoveJobTypeReqT) Reset() {
-	*x = C2S_RemoveJobTypeReqT{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *C2S_AddJobTypeReqT) GetJeScript() string {
+	if x != nil {
+		return x.JeScript
 	}
+	return ""
 }
 
-func (x *C2S_RemoveJobTypeReqT) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *C2S_AddJobTypeReqT) GetFixedParameters() []string {
+	if x != nil {
+		return x.FixedParameters
+	}
+	return nil
 }
 
-func (*C2S_RemoveJobTypeReqT) ProtoMessage() {}
+func (x *C2S_AddJobTypeReqT) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
 
-func (x *C2S_RemoveJobTypeReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *C2S_AddJobTypeReqT) GetWorkflow() string {
+	if x != nil {
+		return x.Workflow
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use C2S_RemoveJobTypeReqT.ProtoReflect.Descriptor instead.
-func (*C2S_RemoveJobTypeReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{34}
+func (x *C2S_AddJobTypeReqT) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
 }
 
-func (x *C2S_RemoveJobTypeReqT) GetJobTypeId() string {
+func (x *C2S_AddJobTypeReqT) GetTimeouts() *Timeouts {
 	if x != nil {
-		return x.JobTypeId
+		return x.Timeouts
 	}
-	return ""
+	return nil
 }
 
-// Response Parameters for Removing a Job Type
-type S2C_RemoveJobTypeRpnT struct {
+// Response Parameters for Adding a Job Type
+type C2S_GetJobTypeReqT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode string `protobuf:"bytes,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service execution
-	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`       // Message from the service after execution
+	JobTypeId string `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"` // ID of the task added
 }
 
-func (x *S2C_RemoveJobTypeRpnT) Reset() {
-	*x = S2C_RemoveJobTypeRpnT{}
+func (x *C2S_GetJobTypeReqT) Reset() {
+	*x = C2S_GetJobTypeReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[35]
+		mi := &file_TransformService2_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *S2C_RemoveJobTypeRpnT) String() string {
+func (x *C2S_GetJobTypeReqT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_RemoveJobTypeRpnT) ProtoMessage() {}
+func (*C2S_GetJobTypeReqT) ProtoMessage() {}
 
-func (x *S2C_RemoveJobTypeRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[35]
+func (x *C2S_GetJobTypeReqT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2553,56 +2833,45 @@ func (x *S2C_RemoveJobTypeRpnT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_RemoveJobTypeRpnT.ProtoReflect.Descriptor instead.
-func (*S2C_RemoveJobTypeRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *S2C_RemoveJobTypeRpnT) GetStatusCode() string {
-	if x != nil {
-		return x.StatusCode
-	}
-	return ""
+// Deprecated: Use C2S_GetJobTypeReqT.ProtoReflect.Descriptor instead.
+func (*C2S_GetJobTypeReqT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *S2C_RemoveJobTypeRpnT) GetMessage() string {
+func (x *C2S_GetJobTypeReqT) GetJobTypeId() string {
 	if x != nil {
-		return x.Message
+		return x.JobTypeId
 	}
 	return ""
 }
 
-// Request Parameters for Setting/Updating a Job Type
-type C2S_SetJobTypeReqT struct {
+type C2S_GetJobTypeRpnT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobTypeId       string   `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"`             // ID of the task to be updated
-	Image           string   `protobuf:"bytes,20,opt,name=Image,proto3" json:"Image,omitempty"`                     // Docker image URL for POD, system image for ECS
-	ReScript        string   `protobuf:"bytes,30,opt,name=ReScript,proto3" json:"ReScript,omitempty"`               // Used to estimate the resources consumed by the task
-	ScScript        string   `protobuf:"bytes,40,opt,name=ScScript,proto3" json:"ScScript,omitempty"`               // Used to collect task status and progress from the output of the command line
-	JeScript        string   `protobuf:"bytes,50,opt,name=JeScript,proto3" json:"JeScript,omitempty"`               // Task entry command
-	FixedParameters []string `protobuf:"bytes,60,rep,name=FixedParameters,proto3" json:"FixedParameters,omitempty"` // Relevant parameters for the task
+	JobType    *JobType `protobuf:"bytes,10,opt,name=JobType,proto3" json:"JobType,omitempty"`
+	StatusCode string   `protobuf:"bytes,20,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"`
+	Message    string   `protobuf:"bytes,30,opt,name=Message,proto3" json:"Message,omitempty"`
 }
 
-func (x *C2S_SetJobTypeReqT) Reset() {
-	*x = C2S_SetJobTypeReqT{}
+func (x *C2S_GetJobTypeRpnT) Reset() {
+	*x = C2S_GetJobTypeRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[36]
+		mi := &file_TransformService2_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *C2S_SetJobTypeReqT) String() string {
+func (x *C2S_GetJobTypeRpnT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2S_SetJobTypeReqT) ProtoMessage() {}
+func (*C2S_GetJobTypeRpnT) ProtoMessage() {}
 
-func (x *C2S_SetJobTypeReqT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[36]
+func (x *C2S_GetJobTypeRpnT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2613,81 +2882,60 @@ func (x *C2S_SetJobTypeReqT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2S_SetJobTypeReqT.ProtoReflect.Descriptor instead.
-func (*C2S_SetJobTypeReqT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{36}
-}
-
-func (x *C2S_SetJobTypeReqT) GetJobTypeId() string {
-	if x != nil {
-		return x.JobTypeId
-	}
-	return ""
-}
-
-func (x *C2S_SetJobTypeReqT) GetImage() string {
-	if x != nil {
-		return x.Image
-	}
-	return ""
+// Deprecated: Use C2S_GetJobTypeRpnT.ProtoReflect.Descriptor instead.
+func (*C2S_GetJobTypeRpnT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *C2S_SetJobTypeReqT) GetReScript() string {
+func (x *C2S_GetJobTypeRpnT) GetJobType() *JobType {
 	if x != nil {
-		return x.ReScript
+		return x.JobType
 	}
-	return ""
+	return nil
 }
 
-func (x *C2S_SetJobTypeReqT) GetScScript() string {
+func (x *C2S_GetJobTypeRpnT) GetStatusCode() string {
 	if x != nil {
-		return x.ScScript
+		return x.StatusCode
 	}
 	return ""
 }
 
-func (x *C2S_SetJobTypeReqT) GetJeScript() string {
+func (x *C2S_GetJobTypeRpnT) GetMessage() string {
 	if x != nil {
-		return x.JeScript
+		return x.Message
 	}
 	return ""
 }
 
-func (x *C2S_SetJobTypeReqT) GetFixedParameters() []string {
-	if x != nil {
-		return x.FixedParameters
-	}
-	return nil
-}
-
-// Response Parameters for Setting/Updating a Job Type
-type S2C_SetJobTypeRpnT struct {
+// Response Parameters for Adding a Job Type
+type S2C_AddJobTypeRpnT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobTypeId  string `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"`   // Relevant task ID that is updated
+	JobTypeId  string `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"`   // ID of the task added
 	StatusCode string `protobuf:"bytes,20,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service execution
 	Message    string `protobuf:"bytes,30,opt,name=Message,proto3" json:"Message,omitempty"`       // Message from the service after execution
 }
 
-func (x *S2C_SetJobTypeRpnT) Reset() {
-	*x = S2C_SetJobTypeRpnT{}
+func (x *S2C_AddJobTypeRpnT) Reset() {
+	*x = S2C_AddJobTypeRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[37]
+		mi := &file_TransformService2_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *S2C_SetJobTypeRpnT) String() string {
+func (x *S2C_AddJobTypeRpnT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_SetJobTypeRpnT) ProtoMessage() {}
+func (*S2C_AddJobTypeRpnT) ProtoMessage() {}
 
-func (x *S2C_SetJobTypeRpnT) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[37]
+func (x *S2C_AddJobTypeRpnT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2698,59 +2946,58 @@ func (x *S2C_SetJobTypeRpnT) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_SetJobTypeRpnT.ProtoReflect.Descriptor instead.
-func (*S2C_SetJobTypeRpnT) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use S2C_AddJobTypeRpnT.ProtoReflect.Descriptor instead.
+func (*S2C_AddJobTypeRpnT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *S2C_SetJobTypeRpnT) GetJobTypeId() string {
+func (x *S2C_AddJobTypeRpnT) GetJobTypeId() string {
 	if x != nil {
 		return x.JobTypeId
 	}
 	return ""
 }
 
-func (x *S2C_SetJobTypeRpnT) GetStatusCode() string {
+func (x *S2C_AddJobTypeRpnT) GetStatusCode() string {
 	if x != nil {
 		return x.StatusCode
 	}
 	return ""
 }
 
-func (x *S2C_SetJobTypeRpnT) GetMessage() string {
+func (x *S2C_AddJobTypeRpnT) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type C2S_CreateJobReq struct {
+// Request Parameters for Removing a Job Type
+type C2S_RemoveJobTypeReqT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobType      int32  `protobuf:"varint,10,opt,name=jobType,proto3" json:"jobType,omitempty"`          // Type of service
-	StorageToken string `protobuf:"bytes,20,opt,name=storageToken,proto3" json:"storageToken,omitempty"` // download and upload token
-	Parameters   string `protobuf:"bytes,30,opt,name=parameters,proto3" json:"parameters,omitempty"`     // Job parameters
+	JobTypeId string `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"` // ID of the job to be removed
 }
 
-func (x *C2S_CreateJobReq) Reset() {
-	*x = C2S_CreateJobReq{}
+func (x *C2S_RemoveJobTypeReqT) Reset() {
+	*x = C2S_RemoveJobTypeReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[38]
+		mi := &file_TransformService2_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *C2S_CreateJobReq) String() string {
+func (x *C2S_RemoveJobTypeReqT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2S_CreateJobReq) ProtoMessage() {}
+func (*C2S_RemoveJobTypeReqT) ProtoMessage() {}
 
-func (x *C2S_CreateJobReq) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[38]
+func (x *C2S_RemoveJobTypeReqT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2761,60 +3008,45 @@ func (x *C2S_CreateJobReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2S_CreateJobReq.ProtoReflect.Descriptor instead.
-func (*C2S_CreateJobReq) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{38}
-}
-
-func (x *C2S_CreateJobReq) GetJobType() int32 {
-	if x != nil {
-		return x.JobType
-	}
-	return 0
-}
-
-func (x *C2S_CreateJobReq) GetStorageToken() string {
-	if x != nil {
-		return x.StorageToken
-	}
-	return ""
+// Deprecated: Use C2S_RemoveJobTypeReqT.ProtoReflect.Descriptor instead.
+func (*C2S_RemoveJobTypeReqT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *C2S_CreateJobReq) GetParameters() string {
+func (x *C2S_RemoveJobTypeReqT) GetJobTypeId() string {
 	if x != nil {
-		return x.Parameters
+		return x.JobTypeId
 	}
 	return ""
 }
 
-// Response Paramter
-type S2C_CreateJobRpn struct {
+// Response Parameters for Removing a Job Type
+type S2C_RemoveJobTypeRpnT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
-	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
-	JobID      string `protobuf:"bytes,30,opt,name=JobID,proto3" json:"JobID,omitempty"`            // ID of the Task added
+	StatusCode string `protobuf:"bytes,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service execution
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`       // Message from the service after execution
 }
 
-func (x *S2C_CreateJobRpn) Reset() {
-	*x = S2C_CreateJobRpn{}
+func (x *S2C_RemoveJobTypeRpnT) Reset() {
+	*x = S2C_RemoveJobTypeRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[39]
+		mi := &file_TransformService2_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *S2C_CreateJobRpn) String() string {
+func (x *S2C_RemoveJobTypeRpnT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_CreateJobRpn) ProtoMessage() {}
+func (*S2C_RemoveJobTypeRpnT) ProtoMessage() {}
 
-func (x *S2C_CreateJobRpn) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[39]
+func (x *S2C_RemoveJobTypeRpnT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2825,58 +3057,60 @@ func (x *S2C_CreateJobRpn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_CreateJobRpn.ProtoReflect.Descriptor instead.
-func (*S2C_CreateJobRpn) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use S2C_RemoveJobTypeRpnT.ProtoReflect.Descriptor instead.
+func (*S2C_RemoveJobTypeRpnT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *S2C_CreateJobRpn) GetStatusCode() int32 {
+func (x *S2C_RemoveJobTypeRpnT) GetStatusCode() string {
 	if x != nil {
 		return x.StatusCode
 	}
-	return 0
-}
-
-func (x *S2C_CreateJobRpn) GetMessage() string {
-	if x != nil {
-		return x.Message
-	}
 	return ""
 }
 
-func (x *S2C_CreateJobRpn) GetJobID() string {
+func (x *S2C_RemoveJobTypeRpnT) GetMessage() string {
 	if x != nil {
-		return x.JobID
+		return x.Message
 	}
 	return ""
 }
 
-// Get Task Information Request
-type C2S_GetJobInfoReq struct {
+// Request Parameters for Setting/Updating a Job Type
+type C2S_SetJobTypeReqT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"` // Identifier of the task (required)
+	JobTypeId       string       `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"`             // ID of the task to be updated
+	Image           string       `protobuf:"bytes,20,opt,name=Image,proto3" json:"Image,omitempty"`                     // Docker image URL for POD, system image for ECS
+	ReScript        string       `protobuf:"bytes,30,opt,name=ReScript,proto3" json:"ReScript,omitempty"`               // Used to estimate the resources consumed by the task
+	ScScript        string       `protobuf:"bytes,40,opt,name=ScScript,proto3" json:"ScScript,omitempty"`               // Used to collect task status and progress from the output of the command line
+	JeScript        string       `protobuf:"bytes,50,opt,name=JeScript,proto3" json:"JeScript,omitempty"`               // Task entry command
+	FixedParameters []string     `protobuf:"bytes,60,rep,name=FixedParameters,proto3" json:"FixedParameters,omitempty"` // Relevant parameters for the task
+	TaskQueue       string       `protobuf:"bytes,70,opt,name=TaskQueue,proto3" json:"TaskQueue,omitempty"`             // Temporal task queue the jobs of this type are dispatched to
+	Workflow        string       `protobuf:"bytes,80,opt,name=Workflow,proto3" json:"Workflow,omitempty"`               // Name of the workflow executing the jobs of this type
+	RetryPolicy     *RetryPolicy `protobuf:"bytes,90,opt,name=RetryPolicy,proto3" json:"RetryPolicy,omitempty"`         // Retries of the activities of the jobs, unset uses the Temporal defaults
+	Timeouts        *Timeouts    `protobuf:"bytes,100,opt,name=Timeouts,proto3" json:"Timeouts,omitempty"`              // Timeouts of the jobs and their activities, unset uses the defaults of the workflow
 }
 
-func (x *C2S_GetJobInfoReq) Reset() {
-	*x = C2S_GetJobInfoReq{}
+func (x *C2S_SetJobTypeReqT) Reset() {
+	*x = C2S_SetJobTypeReqT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[40]
+		mi := &file_TransformService2_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *C2S_GetJobInfoReq) String() string {
+func (x *C2S_SetJobTypeReqT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2S_GetJobInfoReq) ProtoMessage() {}
+func (*C2S_SetJobTypeReqT) ProtoMessage() {}
 
-func (x *C2S_GetJobInfoReq) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[40]
+func (x *C2S_SetJobTypeReqT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2887,46 +3121,109 @@ func (x *C2S_GetJobInfoReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2S_GetJobInfoReq.ProtoReflect.Descriptor instead.
-func (*C2S_GetJobInfoReq) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use C2S_SetJobTypeReqT.ProtoReflect.Descriptor instead.
+func (*C2S_SetJobTypeReqT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *C2S_GetJobInfoReq) GetJobId() string {
+func (x *C2S_SetJobTypeReqT) GetJobTypeId() string {
 	if x != nil {
-		return x.JobId
+		return x.JobTypeId
 	}
 	return ""
 }
 
-// Get Task Information Response
-type S2C_GetJobInfoRpn struct {
+func (x *C2S_SetJobTypeReqT) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *C2S_SetJobTypeReqT) GetReScript() string {
+	if x != nil {
+		return x.ReScript
+	}
+	return ""
+}
+
+func (x *C2S_SetJobTypeReqT) GetScScript() string {
+	if x != nil {
+		return x.ScScript
+	}
+	return ""
+}
+
+func (x *C2S_SetJobTypeReqT) GetJeScript() string {
+	if x != nil {
+		return x.JeScript
+	}
+	return ""
+}
+
+func (x *C2S_SetJobTypeReqT) GetFixedParameters() []string {
+	if x != nil {
+		return x.FixedParameters
+	}
+	return nil
+}
+
+func (x *C2S_SetJobTypeReqT) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *C2S_SetJobTypeReqT) GetWorkflow() string {
+	if x != nil {
+		return x.Workflow
+	}
+	return ""
+}
+
+func (x *C2S_SetJobTypeReqT) GetRetryPolicy() *RetryPolicy {
+	if x != nil {
+		return x.RetryPolicy
+	}
+	return nil
+}
+
+func (x *C2S_SetJobTypeReqT) GetTimeouts() *Timeouts {
+	if x != nil {
+		return x.Timeouts
+	}
+	return nil
+}
+
+// Response Parameters for Setting/Updating a Job Type
+type S2C_SetJobTypeRpnT struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StatusCode int32    `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
-	Message    string   `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
-	Data       *JobInfo `protobuf:"bytes,30,opt,name=data,proto3" json:"data,omitempty"`              // Detailed task information
+	JobTypeId  string `protobuf:"bytes,10,opt,name=JobTypeId,proto3" json:"JobTypeId,omitempty"`   // Relevant task ID that is updated
+	StatusCode string `protobuf:"bytes,20,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service execution
+	Message    string `protobuf:"bytes,30,opt,name=Message,proto3" json:"Message,omitempty"`       // Message from the service after execution
 }
 
-func (x *S2C_GetJobInfoRpn) Reset() {
-	*x = S2C_GetJobInfoRpn{}
+func (x *S2C_SetJobTypeRpnT) Reset() {
+	*x = S2C_SetJobTypeRpnT{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[41]
+		mi := &file_TransformService2_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *S2C_GetJobInfoRpn) String() string {
+func (x *S2C_SetJobTypeRpnT) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_GetJobInfoRpn) ProtoMessage() {}
+func (*S2C_SetJobTypeRpnT) ProtoMessage() {}
 
-func (x *S2C_GetJobInfoRpn) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[41]
+func (x *S2C_SetJobTypeRpnT) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2937,58 +3234,66 @@ func (x *S2C_GetJobInfoRpn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_GetJobInfoRpn.ProtoReflect.Descriptor instead.
-func (*S2C_GetJobInfoRpn) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use S2C_SetJobTypeRpnT.ProtoReflect.Descriptor instead.
+func (*S2C_SetJobTypeRpnT) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *S2C_GetJobInfoRpn) GetStatusCode() int32 {
+func (x *S2C_SetJobTypeRpnT) GetJobTypeId() string {
 	if x != nil {
-		return x.StatusCode
+		return x.JobTypeId
 	}
-	return 0
+	return ""
 }
 
-func (x *S2C_GetJobInfoRpn) GetMessage() string {
+func (x *S2C_SetJobTypeRpnT) GetStatusCode() string {
 	if x != nil {
-		return x.Message
+		return x.StatusCode
 	}
 	return ""
 }
 
-func (x *S2C_GetJobInfoRpn) GetData() *JobInfo {
+func (x *S2C_SetJobTypeRpnT) GetMessage() string {
 	if x != nil {
-		return x.Data
+		return x.Message
 	}
-	return nil
+	return ""
 }
 
-// Cancel Job Request
-type C2S_CancelJobReq struct {
+type C2S_CreateJobReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"` // Identifier of the task (required)
+	JobType        int32    `protobuf:"varint,10,opt,name=jobType,proto3" json:"jobType,omitempty"`              // Type of service
+	StorageToken   string   `protobuf:"bytes,20,opt,name=storageToken,proto3" json:"storageToken,omitempty"`     // download and upload token
+	Parameters     string   `protobuf:"bytes,30,opt,name=parameters,proto3" json:"parameters,omitempty"`         // Job parameters
+	FileSize       int64    `protobuf:"varint,40,opt,name=fileSize,proto3" json:"fileSize,omitempty"`            // Total size of the input files in bytes, used to predict the conversion time
+	IdempotencyKey string   `protobuf:"bytes,50,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"` // Resubmitting with the same key returns the original task instead of creating a new one
+	TargetFormats  []string `protobuf:"bytes,60,rep,name=targetFormats,proto3" json:"targetFormats,omitempty"`   // Formats the files are converted to, checked against the quotas of the caller
+	CallbackUrl    string   `protobuf:"bytes,70,opt,name=callbackUrl,proto3" json:"callbackUrl,omitempty"`       // URL notified of the status changes of the task, replaces the webhook of the organization
+	NotBefore      string   `protobuf:"bytes,80,opt,name=notBefore,proto3" json:"notBefore,omitempty"`           // Time before which the task does not start (RFC 3339), empty starts it right away
+	CronSchedule   string   `protobuf:"bytes,90,opt,name=cronSchedule,proto3" json:"cronSchedule,omitempty"`     // Cron expression of a recurring task, every run is created as a task of its own; times are UTC unless prefixed with CRON_TZ=<zone>
+	SourceFormat   string   `protobuf:"bytes,100,opt,name=sourceFormat,proto3" json:"sourceFormat,omitempty"`    // Format of the input files, e.g. dwg, used to estimate the resources of the task
 }
 
-func (x *C2S_CancelJobReq) Reset() {
-	*x = C2S_CancelJobReq{}
+func (x *C2S_CreateJobReq) Reset() {
+	*x = C2S_CreateJobReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[42]
+		mi := &file_TransformService2_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *C2S_CancelJobReq) String() string {
+func (x *C2S_CreateJobReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*C2S_CancelJobReq) ProtoMessage() {}
+func (*C2S_CreateJobReq) ProtoMessage() {}
 
-func (x *C2S_CancelJobReq) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[42]
+func (x *C2S_CreateJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2999,45 +3304,109 @@ func (x *C2S_CancelJobReq) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use C2S_CancelJobReq.ProtoReflect.Descriptor instead.
-func (*C2S_CancelJobReq) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use C2S_CreateJobReq.ProtoReflect.Descriptor instead.
+func (*C2S_CreateJobReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *C2S_CancelJobReq) GetJobId() string {
+func (x *C2S_CreateJobReq) GetJobType() int32 {
 	if x != nil {
-		return x.JobId
+		return x.JobType
+	}
+	return 0
+}
+
+func (x *C2S_CreateJobReq) GetStorageToken() string {
+	if x != nil {
+		return x.StorageToken
 	}
 	return ""
 }
 
-// Cancel Job Response
-type S2C_CancelJobRpn struct {
+func (x *C2S_CreateJobReq) GetParameters() string {
+	if x != nil {
+		return x.Parameters
+	}
+	return ""
+}
+
+func (x *C2S_CreateJobReq) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *C2S_CreateJobReq) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *C2S_CreateJobReq) GetTargetFormats() []string {
+	if x != nil {
+		return x.TargetFormats
+	}
+	return nil
+}
+
+func (x *C2S_CreateJobReq) GetCallbackUrl() string {
+	if x != nil {
+		return x.CallbackUrl
+	}
+	return ""
+}
+
+func (x *C2S_CreateJobReq) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *C2S_CreateJobReq) GetCronSchedule() string {
+	if x != nil {
+		return x.CronSchedule
+	}
+	return ""
+}
+
+func (x *C2S_CreateJobReq) GetSourceFormat() string {
+	if x != nil {
+		return x.SourceFormat
+	}
+	return ""
+}
+
+// Response Paramter
+type S2C_CreateJobRpn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
 	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	JobID      string `protobuf:"bytes,30,opt,name=JobID,proto3" json:"JobID,omitempty"`            // ID of the Task added
 }
 
-func (x *S2C_CancelJobRpn) Reset() {
-	*x = S2C_CancelJobRpn{}
+func (x *S2C_CreateJobRpn) Reset() {
+	*x = S2C_CreateJobRpn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[43]
+		mi := &file_TransformService2_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *S2C_CancelJobRpn) String() string {
+func (x *S2C_CreateJobRpn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*S2C_CancelJobRpn) ProtoMessage() {}
+func (*S2C_CreateJobRpn) ProtoMessage() {}
 
-func (x *S2C_CancelJobRpn) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[43]
+func (x *S2C_CreateJobRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3048,56 +3417,59 @@ func (x *S2C_CancelJobRpn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use S2C_CancelJobRpn.ProtoReflect.Descriptor instead.
-func (*S2C_CancelJobRpn) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use S2C_CreateJobRpn.ProtoReflect.Descriptor instead.
+func (*S2C_CreateJobRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *S2C_CancelJobRpn) GetStatusCode() int32 {
+func (x *S2C_CreateJobRpn) GetStatusCode() int32 {
 	if x != nil {
 		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *S2C_CancelJobRpn) GetMessage() string {
+func (x *S2C_CreateJobRpn) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-type JobInfo struct {
+func (x *S2C_CreateJobRpn) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// Create Jobs Request, submits a batch of tasks
+type C2S_CreateJobsReq struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JobId     string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"`         // Identifier of the task
-	JobType   string `protobuf:"bytes,20,opt,name=jobType,proto3" json:"jobType,omitempty"`     // Type of the task
-	Status    string `protobuf:"bytes,30,opt,name=status,proto3" json:"status,omitempty"`       // Status of the task
-	Progress  int32  `protobuf:"varint,40,opt,name=progress,proto3" json:"progress,omitempty"`  // Progress of the task
-	Message   string `protobuf:"bytes,50,opt,name=message,proto3" json:"message,omitempty"`     // Message from the task
-	CreatedAt string `protobuf:"bytes,60,opt,name=createdAt,proto3" json:"createdAt,omitempty"` // Time when the task was created
-	UpdatedAt string `protobuf:"bytes,70,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"` // Time when the task was last updated
+	Jobs         []*C2S_CreateJobReq `protobuf:"bytes,10,rep,name=jobs,proto3" json:"jobs,omitempty"`                  // Tasks to create (required)
+	AllOrNothing bool                `protobuf:"varint,20,opt,name=allOrNothing,proto3" json:"allOrNothing,omitempty"` // Reject the whole batch if one task is invalid
 }
 
-func (x *JobInfo) Reset() {
-	*x = JobInfo{}
+func (x *C2S_CreateJobsReq) Reset() {
+	*x = C2S_CreateJobsReq{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_TransformService2_proto_msgTypes[44]
+		mi := &file_TransformService2_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JobInfo) String() string {
+func (x *C2S_CreateJobsReq) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JobInfo) ProtoMessage() {}
+func (*C2S_CreateJobsReq) ProtoMessage() {}
 
-func (x *JobInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_TransformService2_proto_msgTypes[44]
+func (x *C2S_CreateJobsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3108,761 +3480,4811 @@ func (x *JobInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JobInfo.ProtoReflect.Descriptor instead.
-func (*JobInfo) Descriptor() ([]byte, []int) {
-	return file_TransformService2_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use C2S_CreateJobsReq.ProtoReflect.Descriptor instead.
+func (*C2S_CreateJobsReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *JobInfo) GetJobId() string {
+func (x *C2S_CreateJobsReq) GetJobs() []*C2S_CreateJobReq {
 	if x != nil {
-		return x.JobId
+		return x.Jobs
 	}
-	return ""
+	return nil
 }
 
-func (x *JobInfo) GetJobType() string {
+func (x *C2S_CreateJobsReq) GetAllOrNothing() bool {
 	if x != nil {
-		return x.JobType
+		return x.AllOrNothing
 	}
-	return ""
+	return false
 }
 
-func (x *JobInfo) GetStatus() string {
-	if x != nil {
-		return x.Status
-	}
-	return ""
-}
+// Create Jobs Response
+type S2C_CreateJobsRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *JobInfo) GetProgress() int32 {
+	StatusCode int32              `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string             `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Results    []*CreateJobResult `protobuf:"bytes,30,rep,name=results,proto3" json:"results,omitempty"`        // Result of each task, in the order of the request
+}
+
+func (x *S2C_CreateJobsRpn) Reset() {
+	*x = S2C_CreateJobsRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_CreateJobsRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_CreateJobsRpn) ProtoMessage() {}
+
+func (x *S2C_CreateJobsRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_CreateJobsRpn.ProtoReflect.Descriptor instead.
+func (*S2C_CreateJobsRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *S2C_CreateJobsRpn) GetStatusCode() int32 {
 	if x != nil {
-		return x.Progress
+		return x.StatusCode
 	}
 	return 0
 }
 
-func (x *JobInfo) GetMessage() string {
+func (x *S2C_CreateJobsRpn) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-func (x *JobInfo) GetCreatedAt() string {
+func (x *S2C_CreateJobsRpn) GetResults() []*CreateJobResult {
 	if x != nil {
-		return x.CreatedAt
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-func (x *JobInfo) GetUpdatedAt() string {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return ""
+type CreateJobResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index      int32  `protobuf:"varint,10,opt,name=index,proto3" json:"index,omitempty"`           // Index of the task in the request
+	JobId      string `protobuf:"bytes,20,opt,name=jobId,proto3" json:"jobId,omitempty"`            // ID of the Task added, empty if it was rejected
+	StatusCode int32  `protobuf:"varint,30,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // 200 if the task was created, the error code otherwise
+	Message    string `protobuf:"bytes,40,opt,name=Message,proto3" json:"Message,omitempty"`        // Reason why the task was rejected
 }
 
-var File_TransformService2_proto protoreflect.FileDescriptor
+func (x *CreateJobResult) Reset() {
+	*x = CreateJobResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-var file_TransformService2_proto_rawDesc = []byte{
-	0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x1a, 0x1c, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
-	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x18, 0x43, 0x32,
-	0x53, 0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x73,
-	0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x49, 0x64, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
-	0x41, 0x73, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x18, 0x43, 0x32,
-	0x53, 0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
-	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf3, 0x01, 0x0a,
-	0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
-	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x55, 0x72, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x28, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x22, 0x6f, 0x0a, 0x15, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a,
-	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x4a,
-	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x54, 0x79,
-	0x70, 0x65, 0x73, 0x22, 0x93, 0x03, 0x0a, 0x15, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x28, 0x0a,
-	0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49,
-	0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x19, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19, 0x53, 0x79, 0x73, 0x74,
-	0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72,
-	0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a,
-	0x0e, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53,
-	0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a,
-	0x0e, 0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
-	0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x46,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x6b, 0x69, 0x70, 0x18, 0x50, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x64, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9c, 0x02, 0x0a, 0x14, 0x43, 0x32,
-	0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
-	0x5f, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4a, 0x6f, 0x62, 0x53,
-	0x65, 0x74, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x3c,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b,
-	0x69, 0x70, 0x18, 0x50, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70, 0x61,
-	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5f, 0x0a, 0x14, 0x43, 0x32, 0x53, 0x5f,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74,
-	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x73, 0x65, 0x74, 0x73, 0x18,
-	0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
-	0x52, 0x07, 0x4a, 0x6f, 0x62, 0x73, 0x65, 0x74, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x06, 0x4a, 0x6f,
-	0x62, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64,
-	0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x12,
-	0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x28, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x65,
-	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65,
-	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x31, 0x0a, 0x06, 0x4a, 0x6f, 0x62, 0x73, 0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x06, 0x4a, 0x6f, 0x62,
-	0x73, 0x65, 0x74, 0x22, 0x4d, 0x0a, 0x1d, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f,
-	0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x5f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x22, 0x4d, 0x0a, 0x1d, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x70,
-	0x6e, 0x5f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x14,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f,
-	0x74, 0x61, 0x6c, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c,
-	0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x12, 0x43,
-	0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a,
-	0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12,
-	0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
-	0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22,
-	0x33, 0x0a, 0x15, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62,
-	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53,
-	0x65, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53,
-	0x65, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x43,
-	0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x5e, 0x0a, 0x12, 0x43,
-	0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x18, 0x43,
-	0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12,
-	0x1f, 0x0a, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x00, 0x52, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01,
-	0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x9c, 0x01,
-	0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x50, 0x6f,
-	0x6f, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x12,
-	0x1d, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x00, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01, 0x12, 0x17,
-	0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x04,
-	0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x8a, 0x03, 0x0a,
-	0x1a, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x32, 0x0a, 0x14, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
-	0x28, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x61, 0x6d,
-	0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e,
-	0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x73, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0e, 0x49, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x12, 0x34, 0x0a, 0x15, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61,
-	0x74, 0x65, 0x67, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x15, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
-	0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x53, 0x63, 0x61, 0x6c, 0x69,
-	0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x32, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x12, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f,
-	0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x6b, 0x69, 0x70, 0x18, 0x50, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12,
-	0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x70,
-	0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x64, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x1a, 0x43, 0x32,
-	0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
-	0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01,
-	0x01, 0x12, 0x1d, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88, 0x01, 0x01,
-	0x12, 0x45, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
-	0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x43, 0x6f, 0x64, 0x65,
-	0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf8, 0x03, 0x0a,
-	0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x26, 0x0a,
-	0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
-	0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61,
-	0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x73, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49, 0x73, 0x53, 0x68, 0x61,
-	0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74,
-	0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x32, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x53, 0x63,
-	0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x22, 0x0a,
-	0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x3c, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x46, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x18, 0x50, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75,
-	0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x12,
-	0x5b, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x1a, 0x69, 0x0a, 0x13,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x1c,
-	0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
-	0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x14, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0xe2, 0x02, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x12, 0x0a,
-	0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x69, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x53,
-	0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x1e,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
-	0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x53, 0x63, 0x61,
-	0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x65,
-	0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x51,
-	0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12,
-	0x26, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65,
-	0x74, 0x18, 0x46, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x50, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64,
-	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e,
-	0x5f, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
-	0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61,
-	0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e,
-	0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f,
-	0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1b, 0x43, 0x32, 0x53,
-	0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
-	0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x8a, 0x03, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x52, 0x65,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12,
-	0x26, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49,
-	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65,
-	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
-	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
-	0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
-	0x22, 0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
-	0x32, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69,
-	0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x18, 0x46, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x65, 0x66,
-	0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x18, 0x50, 0x20, 0x01, 0x28,
-	0x05, 0x52, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65,
-	0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x18, 0x5a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x22,
-	0x7c, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x52,
-	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f,
-	0x6c, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe1, 0x01,
-	0x0a, 0x13, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53,
-	0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x05, 0x52, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x55, 0x72, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x6d, 0x61, 0x67, 0x65,
-	0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18,
-	0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12,
-	0x1a, 0x0a, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a,
-	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4a,
-	0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64,
-	0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x22, 0x33, 0x0a, 0x13, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62,
-	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x43, 0x32, 0x53, 0x5f, 0x47,
-	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x34,
-	0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x4a, 0x6f, 0x62,
-	0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
-	0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
-	0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x6d,
-	0x0a, 0x13, 0x53, 0x32, 0x43, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70,
-	0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
-	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
-	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a,
-	0x16, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79,
-	0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x16, 0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc7, 0x01, 0x0a, 0x13, 0x43, 0x32,
-	0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f,
-	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x28, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78,
-	0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x3c, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x22, 0x6d, 0x0a, 0x13, 0x53, 0x32, 0x43, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a,
-	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x22, 0x70, 0x0a, 0x10, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70,
-	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
-	0x72, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
-	0x74, 0x65, 0x72, 0x73, 0x22, 0x62, 0x0a, 0x10, 0x53, 0x32, 0x43, 0x5f, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x1e, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x29, 0x0a, 0x11, 0x43, 0x32, 0x53, 0x5f,
-	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
-	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
-	0x62, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x11, 0x53, 0x32, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f,
-	0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61,
-	0x74, 0x61, 0x22, 0x28, 0x0a, 0x10, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
-	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x10,
-	0x53, 0x32, 0x43, 0x5f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e,
-	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x07, 0x4a,
-	0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
-	0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a,
-	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
-	0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x32, 0x99, 0x02, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x56, 0x32,
-	0x12, 0x55, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x23, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52,
-	0x65, 0x71, 0x1a, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x12, 0x5a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f,
-	0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65,
-	0x74, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
-	0x53, 0x32, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x70,
-	0x6e, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62,
-	0x12, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a,
-	0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x43, 0x61,
-	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x32, 0xf3, 0x01, 0x0a,
-	0x10, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x6b, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74,
-	0x54, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x5f,
-	0x74, 0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e,
-	0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x72,
-	0x0a, 0x16, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x65, 0x6e, 0x61,
-	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
-	0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65,
-	0x74, 0x54, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x70, 0x6e,
-	0x5f, 0x74, 0x32, 0xc6, 0x08, 0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79,
-	0x70, 0x65, 0x12, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x26, 0x2e, 0x54, 0x72, 0x61,
-	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53,
-	0x32, 0x43, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e,
-	0x5f, 0x74, 0x12, 0x65, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x29, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x29,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x5c, 0x0a, 0x0a, 0x53, 0x65, 0x74,
-	0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f,
-	0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a,
-	0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x5c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65,
-	0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x26, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
-	0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x62, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f,
-	0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x28, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a,
-	0x28, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x59, 0x0a, 0x09, 0x41, 0x64, 0x64,
-	0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41,
-	0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x25, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52,
-	0x70, 0x6e, 0x5f, 0x74, 0x12, 0x62, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f,
-	0x62, 0x53, 0x65, 0x74, 0x12, 0x28, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x28,
-	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62,
-	0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x59, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4a,
-	0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
-	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65,
-	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x25, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
-	0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70,
-	0x6e, 0x5f, 0x74, 0x12, 0x59, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
-	0x12, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f,
-	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x5f,
-	0x0a, 0x0b, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x27, 0x2e,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x27, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51,
-	0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12,
-	0x7a, 0x0a, 0x14, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72,
-	0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x30, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f,
-	0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x30, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
-	0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x32, 0xc8, 0x04, 0x0a, 0x16,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x61, 0x6e, 0x61,
-	0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6b, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
-	0x53, 0x5f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41,
-	0x64, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70,
-	0x6e, 0x5f, 0x74, 0x12, 0x74, 0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2e, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
-	0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2e, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
-	0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x6b, 0x0a, 0x0f, 0x53, 0x65, 0x74,
-	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2b, 0x2e, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
-	0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
-	0x53, 0x5f, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x6b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
-	0x53, 0x5f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
-	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70,
-	0x6e, 0x5f, 0x74, 0x12, 0x71, 0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
-	0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
-	0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
-	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f,
-	0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f,
-	0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x42, 0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *CreateJobResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var (
-	file_TransformService2_proto_rawDescOnce sync.Once
-	file_TransformService2_proto_rawDescData = file_TransformService2_proto_rawDesc
-)
+func (*CreateJobResult) ProtoMessage() {}
 
-func file_TransformService2_proto_rawDescGZIP() []byte {
-	file_TransformService2_proto_rawDescOnce.Do(func() {
-		file_TransformService2_proto_rawDescData = protoimpl.X.CompressGZIP(file_TransformService2_proto_rawDescData)
-	})
-	return file_TransformService2_proto_rawDescData
+func (x *CreateJobResult) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-var file_TransformService2_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
-var file_TransformService2_proto_goTypes = []interface{}{
-	(*C2S_SetTanentConfigReqT)(nil),      // 0: TransformService2.C2S_SetTanentConfigReq_t
-	(*C2S_SetTanentConfigRpnT)(nil),      // 1: TransformService2.C2S_SetTanentConfigRpn_t
-	(*JobType)(nil),                      // 2: TransformService2.JobType
-	(*C2S_QueryJobTypeRpnT)(nil),         // 3: TransformService2.C2S_QueryJobTypeRpn_t
-	(*C2S_QueryJobTypeReqT)(nil),         // 4: TransformService2.C2S_QueryJobTypeReq_t
-	(*C2S_QueryJobSetReqT)(nil),          // 5: TransformService2.C2S_QueryJobSetReq_t
-	(*C2S_QueryJobSetRpnT)(nil),          // 6: TransformService2.C2S_QueryJobSetRpn_t
-	(*JobSet)(nil),                       // 7: TransformService2.JobSet
-	(*C2S_GetJobSetReqT)(nil),            // 8: TransformService2.C2S_GetJobSetReq_t
-	(*C2S_GetJobSetRpnT)(nil),            // 9: TransformService2.C2S_GetJobSetRpn_t
-	(*C2S_SetJobFixedArgumentsReqT)(nil), // 10: TransformService2.C2S_SetJobFixedArgumentsReq_t
-	(*C2S_SetJobFixedArgumentsRpnT)(nil), // 11: TransformService2.C2S_SetJobFixedArgumentsRpn_t
-	(*C2S_AddJobSetReqT)(nil),            // 12: TransformService2.C2S_AddJobSetReq_t
-	(*C2S_SetJobSetReqT)(nil),            // 13: TransformService2.C2S_SetJobSetReq_t
-	(*C2S_RemoveJobSetReqT)(nil),         // 14: TransformService2.C2S_RemoveJobSetReq_t
-	(*C2S_RemoveJobSetRpnT)(nil),         // 15: TransformService2.C2S_RemoveJobSetRpn_t
-	(*C2S_SetJobSetRpnT)(nil),            // 16: TransformService2.C2S_SetJobSetRpn_t
-	(*C2S_AddJobSetRpnT)(nil),            // 17: TransformService2.C2S_AddJobSetRpn_t
-	(*C2S_GetResourcePoolReqT)(nil),      // 18: TransformService2.C2S_GetResourcePoolReq_t
-	(*C2S_GetResourcePoolRpnT)(nil),      // 19: TransformService2.C2S_GetResourcePoolRpn_t
-	(*C2S_QueryResourcePoolReqT)(nil),    // 20: TransformService2.C2S_QueryResourcePoolReq_t
-	(*C2S_QueryResourcePoolRpnT)(nil),    // 21: TransformService2.C2S_QueryResourcePoolRpn_t
-	(*ResourcePool)(nil),                 // 22: TransformService2.ResourcePool
-	(*ResourceLimitOfTask)(nil),          // 23: TransformService2.ResourceLimitOfTask
-	(*C2S_AddResourcePoolReqT)(nil),      // 24: TransformService2.C2S_AddResourcePoolReq_t
-	(*C2S_AddResourcePoolRpnT)(nil),      // 25: TransformService2.C2S_AddResourcePoolRpn_t
-	(*C2S_RemoveResourcePoolReqT)(nil),   // 26: TransformService2.C2S_RemoveResourcePoolReq_t
-	(*C2S_RemoveResourcePoolRpnT)(nil),   // 27: TransformService2.C2S_RemoveResourcePoolRpn_t
-	(*C2S_SetResourcePoolReqT)(nil),      // 28: TransformService2.C2S_SetResourcePoolReq_t
-	(*C2S_SetResourcePoolRpnT)(nil),      // 29: TransformService2.C2S_SetResourcePoolRpn_t
-	(*C2S_AddJobTypeReqT)(nil),           // 30: TransformService2.C2S_AddJobTypeReq_t
-	(*C2S_GetJobTypeReqT)(nil),           // 31: TransformService2.C2S_GetJobTypeReq_t
-	(*C2S_GetJobTypeRpnT)(nil),           // 32: TransformService2.C2S_GetJobTypeRpn_t
-	(*S2C_AddJobTypeRpnT)(nil),           // 33: TransformService2.S2C_AddJobTypeRpn_t
-	(*C2S_RemoveJobTypeReqT)(nil),        // 34: TransformService2.C2S_RemoveJobTypeReq_t
-	(*S2C_RemoveJobTypeRpnT)(nil),        // 35: TransformService2.S2C_RemoveJobTypeRpn_t
-	(*C2S_SetJobTypeReqT)(nil),           // 36: TransformService2.C2S_SetJobTypeReq_t
-	(*S2C_SetJobTypeRpnT)(nil),           // 37: TransformService2.S2C_SetJobTypeRpn_t
-	(*C2S_CreateJobReq)(nil),             // 38: TransformService2.C2S_CreateJobReq
-	(*S2C_CreateJobRpn)(nil),             // 39: TransformService2.S2C_CreateJobRpn
-	(*C2S_GetJobInfoReq)(nil),            // 40: TransformService2.C2S_GetJobInfoReq
-	(*S2C_GetJobInfoRpn)(nil),            // 41: TransformService2.S2C_GetJobInfoRpn
-	(*C2S_CancelJobReq)(nil),             // 42: TransformService2.C2S_CancelJobReq
-	(*S2C_CancelJobRpn)(nil),             // 43: TransformService2.S2C_CancelJobRpn
-	(*JobInfo)(nil),                      // 44: TransformService2.JobInfo
-	nil,                                  // 45: TransformService2.ResourcePool.ResourceLimitsEntry
+// Deprecated: Use CreateJobResult.ProtoReflect.Descriptor instead.
+func (*CreateJobResult) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{48}
 }
-var file_TransformService2_proto_depIdxs = []int32{
-	2,  // 0: TransformService2.C2S_QueryJobTypeRpn_t.JobTypes:type_name -> TransformService2.JobType
-	7,  // 1: TransformService2.C2S_QueryJobSetRpn_t.Jobsets:type_name -> TransformService2.JobSet
-	7,  // 2: TransformService2.C2S_GetJobSetRpn_t.Jobset:type_name -> TransformService2.JobSet
-	22, // 3: TransformService2.C2S_GetResourcePoolRpn_t.Pool:type_name -> TransformService2.ResourcePool
-	22, // 4: TransformService2.C2S_QueryResourcePoolRpn_t.ResourcePools:type_name -> TransformService2.ResourcePool
-	45, // 5: TransformService2.ResourcePool.ResourceLimits:type_name -> TransformService2.ResourcePool.ResourceLimitsEntry
-	23, // 6: TransformService2.C2S_AddResourcePoolReq_t.ResourceLimit:type_name -> TransformService2.ResourceLimitOfTask
-	23, // 7: TransformService2.C2S_SetResourcePoolReq_t.ResourceLimit:type_name -> TransformService2.ResourceLimitOfTask
-	2,  // 8: TransformService2.C2S_GetJobTypeRpn_t.JobType:type_name -> TransformService2.JobType
-	44, // 9: TransformService2.S2C_GetJobInfoRpn.data:type_name -> TransformService2.JobInfo
-	23, // 10: TransformService2.ResourcePool.ResourceLimitsEntry.value:type_name -> TransformService2.ResourceLimitOfTask
-	38, // 11: TransformService2.TransformV2.CreateJob:input_type -> TransformService2.C2S_CreateJobReq
-	40, // 12: TransformService2.TransformV2.GetJobInfo:input_type -> TransformService2.C2S_GetJobInfoReq
-	42, // 13: TransformService2.TransformV2.CancelJob:input_type -> TransformService2.C2S_CancelJobReq
-	0,  // 14: TransformService2.TenantManagement.SetTenantConfig:input_type -> TransformService2.C2S_SetTanentConfigReq_t
-	0,  // 15: TransformService2.TenantManagement.SetDefaultTenantConfig:input_type -> TransformService2.C2S_SetTanentConfigReq_t
-	30, // 16: TransformService2.JobManagement.AddJobType:input_type -> TransformService2.C2S_AddJobTypeReq_t
-	34, // 17: TransformService2.JobManagement.RemoveJobType:input_type -> TransformService2.C2S_RemoveJobTypeReq_t
-	36, // 18: TransformService2.JobManagement.SetJobType:input_type -> TransformService2.C2S_SetJobTypeReq_t
-	31, // 19: TransformService2.JobManagement.GetJobType:input_type -> TransformService2.C2S_GetJobTypeReq_t
-	4,  // 20: TransformService2.JobManagement.QueryJobType:input_type -> TransformService2.C2S_QueryJobTypeReq_t
-	12, // 21: TransformService2.JobManagement.AddJobSet:input_type -> TransformService2.C2S_AddJobSetReq_t
-	14, // 22: TransformService2.JobManagement.RemoveJobSet:input_type -> TransformService2.C2S_RemoveJobSetReq_t
-	13, // 23: TransformService2.JobManagement.SetJobSet:input_type -> TransformService2.C2S_SetJobSetReq_t
-	8,  // 24: TransformService2.JobManagement.GetJobSet:input_type -> TransformService2.C2S_GetJobSetReq_t
-	5,  // 25: TransformService2.JobManagement.QueryJobSet:input_type -> TransformService2.C2S_QueryJobSetReq_t
-	10, // 26: TransformService2.JobManagement.SetJobFixedArguments:input_type -> TransformService2.C2S_SetJobFixedArgumentsReq_t
-	24, // 27: TransformService2.ResourcePoolManagement.AddResourcePool:input_type -> TransformService2.C2S_AddResourcePoolReq_t
-	26, // 28: TransformService2.ResourcePoolManagement.RemoveResourcePool:input_type -> TransformService2.C2S_RemoveResourcePoolReq_t
-	28, // 29: TransformService2.ResourcePoolManagement.SetResourcePool:input_type -> TransformService2.C2S_SetResourcePoolReq_t
-	18, // 30: TransformService2.ResourcePoolManagement.GetResourcePool:input_type -> TransformService2.C2S_GetResourcePoolReq_t
-	20, // 31: TransformService2.ResourcePoolManagement.QueryResourcePool:input_type -> TransformService2.C2S_QueryResourcePoolReq_t
-	39, // 32: TransformService2.TransformV2.CreateJob:output_type -> TransformService2.S2C_CreateJobRpn
-	41, // 33: TransformService2.TransformV2.GetJobInfo:output_type -> TransformService2.S2C_GetJobInfoRpn
-	43, // 34: TransformService2.TransformV2.CancelJob:output_type -> TransformService2.S2C_CancelJobRpn
-	1,  // 35: TransformService2.TenantManagement.SetTenantConfig:output_type -> TransformService2.C2S_SetTanentConfigRpn_t
-	1,  // 36: TransformService2.TenantManagement.SetDefaultTenantConfig:output_type -> TransformService2.C2S_SetTanentConfigRpn_t
-	33, // 37: TransformService2.JobManagement.AddJobType:output_type -> TransformService2.S2C_AddJobTypeRpn_t
-	35, // 38: TransformService2.JobManagement.RemoveJobType:output_type -> TransformService2.S2C_RemoveJobTypeRpn_t
-	37, // 39: TransformService2.JobManagement.SetJobType:output_type -> TransformService2.S2C_SetJobTypeRpn_t
-	32, // 40: TransformService2.JobManagement.GetJobType:output_type -> TransformService2.C2S_GetJobTypeRpn_t
-	3,  // 41: TransformService2.JobManagement.QueryJobType:output_type -> TransformService2.C2S_QueryJobTypeRpn_t
-	17, // 42: TransformService2.JobManagement.AddJobSet:output_type -> TransformService2.C2S_AddJobSetRpn_t
-	15, // 43: TransformService2.JobManagement.RemoveJobSet:output_type -> TransformService2.C2S_RemoveJobSetRpn_t
-	16, // 44: TransformService2.JobManagement.SetJobSet:output_type -> TransformService2.C2S_SetJobSetRpn_t
-	9,  // 45: TransformService2.JobManagement.GetJobSet:output_type -> TransformService2.C2S_GetJobSetRpn_t
-	6,  // 46: TransformService2.JobManagement.QueryJobSet:output_type -> TransformService2.C2S_QueryJobSetRpn_t
-	11, // 47: TransformService2.JobManagement.SetJobFixedArguments:output_type -> TransformService2.C2S_SetJobFixedArgumentsRpn_t
-	25, // 48: TransformService2.ResourcePoolManagement.AddResourcePool:output_type -> TransformService2.C2S_AddResourcePoolRpn_t
-	27, // 49: TransformService2.ResourcePoolManagement.RemoveResourcePool:output_type -> TransformService2.C2S_RemoveResourcePoolRpn_t
-	29, // 50: TransformService2.ResourcePoolManagement.SetResourcePool:output_type -> TransformService2.C2S_SetResourcePoolRpn_t
-	19, // 51: TransformService2.ResourcePoolManagement.GetResourcePool:output_type -> TransformService2.C2S_GetResourcePoolRpn_t
-	21, // 52: TransformService2.ResourcePoolManagement.QueryResourcePool:output_type -> TransformService2.C2S_QueryResourcePoolRpn_t
-	32, // [32:53] is the sub-list for method output_type
-	11, // [11:32] is the sub-list for method input_type
-	11, // [11:11] is the sub-list for extension type_name
-	11, // [11:11] is the sub-list for extension extendee
-	0,  // [0:11] is the sub-list for field type_name
+
+func (x *CreateJobResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
 }
 
-func init() { file_TransformService2_proto_init() }
-func file_TransformService2_proto_init() {
-	if File_TransformService2_proto != nil {
-		return
+func (x *CreateJobResult) GetJobId() string {
+	if x != nil {
+		return x.JobId
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_TransformService2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetTanentConfigReqT); i {
+	return ""
+}
+
+func (x *CreateJobResult) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *CreateJobResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Get Task Information Request
+type C2S_GetJobInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"` // Identifier of the task (required)
+}
+
+func (x *C2S_GetJobInfoReq) Reset() {
+	*x = C2S_GetJobInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_GetJobInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_GetJobInfoReq) ProtoMessage() {}
+
+func (x *C2S_GetJobInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_GetJobInfoReq.ProtoReflect.Descriptor instead.
+func (*C2S_GetJobInfoReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *C2S_GetJobInfoReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Get Task Information Response
+type S2C_GetJobInfoRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32    `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string   `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Data       *JobInfo `protobuf:"bytes,30,opt,name=data,proto3" json:"data,omitempty"`              // Detailed task information
+}
+
+func (x *S2C_GetJobInfoRpn) Reset() {
+	*x = S2C_GetJobInfoRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_GetJobInfoRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_GetJobInfoRpn) ProtoMessage() {}
+
+func (x *S2C_GetJobInfoRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_GetJobInfoRpn.ProtoReflect.Descriptor instead.
+func (*S2C_GetJobInfoRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *S2C_GetJobInfoRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_GetJobInfoRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_GetJobInfoRpn) GetData() *JobInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// Cancel Job Request
+type C2S_CancelJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId  string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"`   // Identifier of the task (required)
+	Reason string `protobuf:"bytes,20,opt,name=reason,proto3" json:"reason,omitempty"` // Reason of the cancellation, recorded on the task
+}
+
+func (x *C2S_CancelJobReq) Reset() {
+	*x = C2S_CancelJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_CancelJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_CancelJobReq) ProtoMessage() {}
+
+func (x *C2S_CancelJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_CancelJobReq.ProtoReflect.Descriptor instead.
+func (*C2S_CancelJobReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *C2S_CancelJobReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *C2S_CancelJobReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Cancel Job Response
+type S2C_CancelJobRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+}
+
+func (x *S2C_CancelJobRpn) Reset() {
+	*x = S2C_CancelJobRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_CancelJobRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_CancelJobRpn) ProtoMessage() {}
+
+func (x *S2C_CancelJobRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_CancelJobRpn.ProtoReflect.Descriptor instead.
+func (*S2C_CancelJobRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *S2C_CancelJobRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_CancelJobRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Pause Job Request, the task dispatches no further files until it is resumed
+type C2S_PauseJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId  string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"`   // Identifier of the task (required)
+	Reason string `protobuf:"bytes,20,opt,name=reason,proto3" json:"reason,omitempty"` // Reason of the pause, recorded on the task
+}
+
+func (x *C2S_PauseJobReq) Reset() {
+	*x = C2S_PauseJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_PauseJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_PauseJobReq) ProtoMessage() {}
+
+func (x *C2S_PauseJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_PauseJobReq.ProtoReflect.Descriptor instead.
+func (*C2S_PauseJobReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *C2S_PauseJobReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *C2S_PauseJobReq) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Pause Job Response
+type S2C_PauseJobRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+}
+
+func (x *S2C_PauseJobRpn) Reset() {
+	*x = S2C_PauseJobRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_PauseJobRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_PauseJobRpn) ProtoMessage() {}
+
+func (x *S2C_PauseJobRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_PauseJobRpn.ProtoReflect.Descriptor instead.
+func (*S2C_PauseJobRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *S2C_PauseJobRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_PauseJobRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Resume Job Request, dispatches the remaining files of a paused task; a task of a shared pool is queued until the pool has free slots
+type C2S_ResumeJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"` // Identifier of the task (required)
+}
+
+func (x *C2S_ResumeJobReq) Reset() {
+	*x = C2S_ResumeJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_ResumeJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_ResumeJobReq) ProtoMessage() {}
+
+func (x *C2S_ResumeJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_ResumeJobReq.ProtoReflect.Descriptor instead.
+func (*C2S_ResumeJobReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *C2S_ResumeJobReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Resume Job Response
+type S2C_ResumeJobRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+}
+
+func (x *S2C_ResumeJobRpn) Reset() {
+	*x = S2C_ResumeJobRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_ResumeJobRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_ResumeJobRpn) ProtoMessage() {}
+
+func (x *S2C_ResumeJobRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_ResumeJobRpn.ProtoReflect.Descriptor instead.
+func (*S2C_ResumeJobRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *S2C_ResumeJobRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_ResumeJobRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Retry Job Request, reruns the failed files of a finished task as a new task
+type C2S_RetryJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"` // Identifier of the task to retry (required)
+}
+
+func (x *C2S_RetryJobReq) Reset() {
+	*x = C2S_RetryJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_RetryJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_RetryJobReq) ProtoMessage() {}
+
+func (x *C2S_RetryJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_RetryJobReq.ProtoReflect.Descriptor instead.
+func (*C2S_RetryJobReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *C2S_RetryJobReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Retry Job Response
+type S2C_RetryJobRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	JobID      string `protobuf:"bytes,30,opt,name=JobID,proto3" json:"JobID,omitempty"`            // ID of the Task added for the retry
+}
+
+func (x *S2C_RetryJobRpn) Reset() {
+	*x = S2C_RetryJobRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_RetryJobRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_RetryJobRpn) ProtoMessage() {}
+
+func (x *S2C_RetryJobRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_RetryJobRpn.ProtoReflect.Descriptor instead.
+func (*S2C_RetryJobRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *S2C_RetryJobRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_RetryJobRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_RetryJobRpn) GetJobID() string {
+	if x != nil {
+		return x.JobID
+	}
+	return ""
+}
+
+// Get Job Queue Information Request
+type C2S_GetJobQueueInfoReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"` // Identifier of the task (required)
+}
+
+func (x *C2S_GetJobQueueInfoReq) Reset() {
+	*x = C2S_GetJobQueueInfoReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_GetJobQueueInfoReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_GetJobQueueInfoReq) ProtoMessage() {}
+
+func (x *C2S_GetJobQueueInfoReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_GetJobQueueInfoReq.ProtoReflect.Descriptor instead.
+func (*C2S_GetJobQueueInfoReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *C2S_GetJobQueueInfoReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+// Get Job Queue Information Response
+type S2C_GetJobQueueInfoRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32         `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string        `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Data       *JobQueueInfo `protobuf:"bytes,30,opt,name=data,proto3" json:"data,omitempty"`              // Queue information and predicted times of the task
+}
+
+func (x *S2C_GetJobQueueInfoRpn) Reset() {
+	*x = S2C_GetJobQueueInfoRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_GetJobQueueInfoRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_GetJobQueueInfoRpn) ProtoMessage() {}
+
+func (x *S2C_GetJobQueueInfoRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_GetJobQueueInfoRpn.ProtoReflect.Descriptor instead.
+func (*S2C_GetJobQueueInfoRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *S2C_GetJobQueueInfoRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_GetJobQueueInfoRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_GetJobQueueInfoRpn) GetData() *JobQueueInfo {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type JobQueueInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId             string  `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"`                          // Identifier of the task
+	TaskQueue         string  `protobuf:"bytes,20,opt,name=taskQueue,proto3" json:"taskQueue,omitempty"`                  // Task queue of the task
+	Position          int32   `protobuf:"varint,30,opt,name=position,proto3" json:"position,omitempty"`                   // Position of the task in the queue, 0 once the task is running
+	QueueLength       int32   `protobuf:"varint,40,opt,name=queueLength,proto3" json:"queueLength,omitempty"`             // Number of tasks waiting in the queue
+	Running           int32   `protobuf:"varint,50,opt,name=running,proto3" json:"running,omitempty"`                     // Number of tasks running on the queue
+	QueueSeconds      int64   `protobuf:"varint,60,opt,name=queueSeconds,proto3" json:"queueSeconds,omitempty"`           // Predicted time in seconds until the task starts
+	ConversionSeconds int64   `protobuf:"varint,70,opt,name=conversionSeconds,proto3" json:"conversionSeconds,omitempty"` // Predicted conversion time of the task in seconds
+	PredictedStartAt  string  `protobuf:"bytes,80,opt,name=predictedStartAt,proto3" json:"predictedStartAt,omitempty"`    // Predicted time when the task starts
+	PredictedFinishAt string  `protobuf:"bytes,90,opt,name=predictedFinishAt,proto3" json:"predictedFinishAt,omitempty"`  // Predicted time when the task finishes
+	CpuCores          float64 `protobuf:"fixed64,100,opt,name=cpuCores,proto3" json:"cpuCores,omitempty"`                 // Estimated CPU cores of the task, 0 if not estimated
+	MemoryMB          int64   `protobuf:"varint,110,opt,name=memoryMB,proto3" json:"memoryMB,omitempty"`                  // Estimated memory of the task in megabytes, 0 if not estimated
+	EstimateSource    string  `protobuf:"bytes,120,opt,name=estimateSource,proto3" json:"estimateSource,omitempty"`       // How the conversion time was estimated: ReScript or History
+}
+
+func (x *JobQueueInfo) Reset() {
+	*x = JobQueueInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobQueueInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobQueueInfo) ProtoMessage() {}
+
+func (x *JobQueueInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobQueueInfo.ProtoReflect.Descriptor instead.
+func (*JobQueueInfo) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *JobQueueInfo) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobQueueInfo) GetTaskQueue() string {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return ""
+}
+
+func (x *JobQueueInfo) GetPosition() int32 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *JobQueueInfo) GetQueueLength() int32 {
+	if x != nil {
+		return x.QueueLength
+	}
+	return 0
+}
+
+func (x *JobQueueInfo) GetRunning() int32 {
+	if x != nil {
+		return x.Running
+	}
+	return 0
+}
+
+func (x *JobQueueInfo) GetQueueSeconds() int64 {
+	if x != nil {
+		return x.QueueSeconds
+	}
+	return 0
+}
+
+func (x *JobQueueInfo) GetConversionSeconds() int64 {
+	if x != nil {
+		return x.ConversionSeconds
+	}
+	return 0
+}
+
+func (x *JobQueueInfo) GetPredictedStartAt() string {
+	if x != nil {
+		return x.PredictedStartAt
+	}
+	return ""
+}
+
+func (x *JobQueueInfo) GetPredictedFinishAt() string {
+	if x != nil {
+		return x.PredictedFinishAt
+	}
+	return ""
+}
+
+func (x *JobQueueInfo) GetCpuCores() float64 {
+	if x != nil {
+		return x.CpuCores
+	}
+	return 0
+}
+
+func (x *JobQueueInfo) GetMemoryMB() int64 {
+	if x != nil {
+		return x.MemoryMB
+	}
+	return 0
+}
+
+func (x *JobQueueInfo) GetEstimateSource() string {
+	if x != nil {
+		return x.EstimateSource
+	}
+	return ""
+}
+
+// Watch Job Request
+type C2S_WatchJobReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId   string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"`      // Identifier of the task (required)
+	FromSeq int64  `protobuf:"varint,20,opt,name=fromSeq,proto3" json:"fromSeq,omitempty"` // Sequence number of the last received event, 0 sends all events of the task
+}
+
+func (x *C2S_WatchJobReq) Reset() {
+	*x = C2S_WatchJobReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_WatchJobReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_WatchJobReq) ProtoMessage() {}
+
+func (x *C2S_WatchJobReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_WatchJobReq.ProtoReflect.Descriptor instead.
+func (*C2S_WatchJobReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *C2S_WatchJobReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *C2S_WatchJobReq) GetFromSeq() int64 {
+	if x != nil {
+		return x.FromSeq
+	}
+	return 0
+}
+
+// Watch Jobs Request, watches all tasks of the organization of the caller
+type C2S_WatchJobsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FromSeq int64 `protobuf:"varint,10,opt,name=fromSeq,proto3" json:"fromSeq,omitempty"` // Sequence number of the last received event, 0 sends only new events
+}
+
+func (x *C2S_WatchJobsReq) Reset() {
+	*x = C2S_WatchJobsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_WatchJobsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_WatchJobsReq) ProtoMessage() {}
+
+func (x *C2S_WatchJobsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_WatchJobsReq.ProtoReflect.Descriptor instead.
+func (*C2S_WatchJobsReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *C2S_WatchJobsReq) GetFromSeq() int64 {
+	if x != nil {
+		return x.FromSeq
+	}
+	return 0
+}
+
+// Status or progress change of a task
+type JobEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seq           int64           `protobuf:"varint,10,opt,name=seq,proto3" json:"seq,omitempty"`                    // Sequence number of the event, increases over all tasks
+	JobId         string          `protobuf:"bytes,20,opt,name=jobId,proto3" json:"jobId,omitempty"`                 // Identifier of the task
+	Status        string          `protobuf:"bytes,30,opt,name=status,proto3" json:"status,omitempty"`               // Status of the task
+	Progress      int32           `protobuf:"varint,40,opt,name=progress,proto3" json:"progress,omitempty"`          // Progress of the task
+	Message       string          `protobuf:"bytes,50,opt,name=message,proto3" json:"message,omitempty"`             // Message from the task
+	CreatedAt     string          `protobuf:"bytes,60,opt,name=createdAt,proto3" json:"createdAt,omitempty"`         // Time of the change
+	Files         []*FileProgress `protobuf:"bytes,70,rep,name=files,proto3" json:"files,omitempty"`                 // Progress of each file of the task
+	ErrorCategory string          `protobuf:"bytes,80,opt,name=errorCategory,proto3" json:"errorCategory,omitempty"` // Category of the failure of the task
+}
+
+func (x *JobEvent) Reset() {
+	*x = JobEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobEvent) ProtoMessage() {}
+
+func (x *JobEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobEvent.ProtoReflect.Descriptor instead.
+func (*JobEvent) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *JobEvent) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *JobEvent) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobEvent) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobEvent) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *JobEvent) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JobEvent) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JobEvent) GetFiles() []*FileProgress {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *JobEvent) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+// List Jobs Request, lists the tasks of the organization of the caller, newest first
+type C2S_ListJobsReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status     string `protobuf:"bytes,10,opt,name=status,proto3" json:"status,omitempty"`         // Only list tasks with this status, e.g. Scheduled lists the recurring tasks
+	ScheduleId string `protobuf:"bytes,20,opt,name=scheduleId,proto3" json:"scheduleId,omitempty"` // Only list the runs of this recurring task
+	Skip       int32  `protobuf:"varint,30,opt,name=skip,proto3" json:"skip,omitempty"`            // Number of tasks to skip
+	Limit      int32  `protobuf:"varint,40,opt,name=limit,proto3" json:"limit,omitempty"`          // Maximum number of tasks to return, 100 if not set
+}
+
+func (x *C2S_ListJobsReq) Reset() {
+	*x = C2S_ListJobsReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_ListJobsReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_ListJobsReq) ProtoMessage() {}
+
+func (x *C2S_ListJobsReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_ListJobsReq.ProtoReflect.Descriptor instead.
+func (*C2S_ListJobsReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *C2S_ListJobsReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *C2S_ListJobsReq) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *C2S_ListJobsReq) GetSkip() int32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *C2S_ListJobsReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// List Jobs Response
+type S2C_ListJobsRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32      `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string     `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Jobs       []*JobInfo `protobuf:"bytes,30,rep,name=jobs,proto3" json:"jobs,omitempty"`              // Tasks found
+}
+
+func (x *S2C_ListJobsRpn) Reset() {
+	*x = S2C_ListJobsRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_ListJobsRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_ListJobsRpn) ProtoMessage() {}
+
+func (x *S2C_ListJobsRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_ListJobsRpn.ProtoReflect.Descriptor instead.
+func (*S2C_ListJobsRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *S2C_ListJobsRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_ListJobsRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_ListJobsRpn) GetJobs() []*JobInfo {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+type JobInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId         string          `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"`                 // Identifier of the task
+	JobType       string          `protobuf:"bytes,20,opt,name=jobType,proto3" json:"jobType,omitempty"`             // Type of the task
+	Status        string          `protobuf:"bytes,30,opt,name=status,proto3" json:"status,omitempty"`               // Status of the task: Scheduled, Queued, Running, Paused, Succeeded, PartiallyFailed, Failed or Cancelled
+	Progress      int32           `protobuf:"varint,40,opt,name=progress,proto3" json:"progress,omitempty"`          // Progress of the task
+	Message       string          `protobuf:"bytes,50,opt,name=message,proto3" json:"message,omitempty"`             // Message from the task
+	CreatedAt     string          `protobuf:"bytes,60,opt,name=createdAt,proto3" json:"createdAt,omitempty"`         // Time when the task was created
+	UpdatedAt     string          `protobuf:"bytes,70,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`         // Time when the task was last updated
+	Files         []*FileProgress `protobuf:"bytes,80,rep,name=files,proto3" json:"files,omitempty"`                 // Progress of each file of the task
+	ErrorCategory string          `protobuf:"bytes,90,opt,name=errorCategory,proto3" json:"errorCategory,omitempty"` // Category of the failure of the task, e.g. UnsupportedFormat
+	RetryOf       string          `protobuf:"bytes,100,opt,name=retryOf,proto3" json:"retryOf,omitempty"`            // Identifier of the task this task retries
+	Priority      string          `protobuf:"bytes,110,opt,name=priority,proto3" json:"priority,omitempty"`          // Priority of the task, VIP or Normal
+	NotBefore     string          `protobuf:"bytes,120,opt,name=notBefore,proto3" json:"notBefore,omitempty"`        // Time before which the task does not start, empty if it was not delayed
+	CronSchedule  string          `protobuf:"bytes,130,opt,name=cronSchedule,proto3" json:"cronSchedule,omitempty"`  // Cron expression of a recurring task, its status is Scheduled until it is cancelled
+	ScheduleId    string          `protobuf:"bytes,140,opt,name=scheduleId,proto3" json:"scheduleId,omitempty"`      // Identifier of the recurring task that created this run
+	NextRunAt     string          `protobuf:"bytes,150,opt,name=nextRunAt,proto3" json:"nextRunAt,omitempty"`        // Time of the next run of a recurring task
+}
+
+func (x *JobInfo) Reset() {
+	*x = JobInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JobInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JobInfo) ProtoMessage() {}
+
+func (x *JobInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JobInfo.ProtoReflect.Descriptor instead.
+func (*JobInfo) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *JobInfo) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *JobInfo) GetJobType() string {
+	if x != nil {
+		return x.JobType
+	}
+	return ""
+}
+
+func (x *JobInfo) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *JobInfo) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *JobInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *JobInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *JobInfo) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *JobInfo) GetFiles() []*FileProgress {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *JobInfo) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *JobInfo) GetRetryOf() string {
+	if x != nil {
+		return x.RetryOf
+	}
+	return ""
+}
+
+func (x *JobInfo) GetPriority() string {
+	if x != nil {
+		return x.Priority
+	}
+	return ""
+}
+
+func (x *JobInfo) GetNotBefore() string {
+	if x != nil {
+		return x.NotBefore
+	}
+	return ""
+}
+
+func (x *JobInfo) GetCronSchedule() string {
+	if x != nil {
+		return x.CronSchedule
+	}
+	return ""
+}
+
+func (x *JobInfo) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *JobInfo) GetNextRunAt() string {
+	if x != nil {
+		return x.NextRunAt
+	}
+	return ""
+}
+
+type FileProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File          string   `protobuf:"bytes,10,opt,name=file,proto3" json:"file,omitempty"`                   // Name of the file
+	Status        string   `protobuf:"bytes,20,opt,name=status,proto3" json:"status,omitempty"`               // Status of the file conversion
+	Progress      int32    `protobuf:"varint,30,opt,name=progress,proto3" json:"progress,omitempty"`          // Progress of the file conversion
+	Message       string   `protobuf:"bytes,40,opt,name=message,proto3" json:"message,omitempty"`             // Message from the file conversion
+	ErrorCategory string   `protobuf:"bytes,50,opt,name=errorCategory,proto3" json:"errorCategory,omitempty"` // Category of the failure of the file conversion
+	Outputs       []string `protobuf:"bytes,60,rep,name=outputs,proto3" json:"outputs,omitempty"`             // Files written by the conversion
+	DurationMs    int64    `protobuf:"varint,70,opt,name=durationMs,proto3" json:"durationMs,omitempty"`      // Time the file took to finish in milliseconds
+}
+
+func (x *FileProgress) Reset() {
+	*x = FileProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FileProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileProgress) ProtoMessage() {}
+
+func (x *FileProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileProgress.ProtoReflect.Descriptor instead.
+func (*FileProgress) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *FileProgress) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *FileProgress) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *FileProgress) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+func (x *FileProgress) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FileProgress) GetErrorCategory() string {
+	if x != nil {
+		return x.ErrorCategory
+	}
+	return ""
+}
+
+func (x *FileProgress) GetOutputs() []string {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
+func (x *FileProgress) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+// Quota of an organization or a user. Every enabled quota matching the Zixel-Organization-Id
+// and Zixel-User-Id headers of CreateJob must allow the task, callers without quotas are not limited.
+type Quota struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuotaId              string   `protobuf:"bytes,10,opt,name=quotaId,proto3" json:"quotaId,omitempty"`                            // Identifier of the quota
+	TenantId             string   `protobuf:"bytes,20,opt,name=tenantId,proto3" json:"tenantId,omitempty"`                          // Organization the quota applies to, empty applies to the user in every organization
+	UserId               string   `protobuf:"bytes,30,opt,name=userId,proto3" json:"userId,omitempty"`                              // User the quota applies to, empty applies to all users of the organization
+	AvailableConversions int64    `protobuf:"varint,40,opt,name=availableConversions,proto3" json:"availableConversions,omitempty"` // Tasks that can still be created, -1 allows any number
+	UsedConversions      int64    `protobuf:"varint,50,opt,name=usedConversions,proto3" json:"usedConversions,omitempty"`           // Tasks created with the quota
+	ValidFrom            string   `protobuf:"bytes,60,opt,name=validFrom,proto3" json:"validFrom,omitempty"`                        // Time from when the quota is valid (RFC 3339), empty is valid right away
+	ValidUntil           string   `protobuf:"bytes,70,opt,name=validUntil,proto3" json:"validUntil,omitempty"`                      // Time until when the quota is valid (RFC 3339), empty never expires
+	JobTypes             []int32  `protobuf:"varint,80,rep,packed,name=jobTypes,proto3" json:"jobTypes,omitempty"`                  // Allowed task types, empty allows all types
+	TargetFormats        []string `protobuf:"bytes,90,rep,name=targetFormats,proto3" json:"targetFormats,omitempty"`                // Allowed target formats, empty allows all formats
+	IsEnable             bool     `protobuf:"varint,100,opt,name=isEnable,proto3" json:"isEnable,omitempty"`                        // Disabled quotas are ignored
+}
+
+func (x *Quota) Reset() {
+	*x = Quota{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Quota) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Quota) ProtoMessage() {}
+
+func (x *Quota) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Quota.ProtoReflect.Descriptor instead.
+func (*Quota) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *Quota) GetQuotaId() string {
+	if x != nil {
+		return x.QuotaId
+	}
+	return ""
+}
+
+func (x *Quota) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Quota) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Quota) GetAvailableConversions() int64 {
+	if x != nil {
+		return x.AvailableConversions
+	}
+	return 0
+}
+
+func (x *Quota) GetUsedConversions() int64 {
+	if x != nil {
+		return x.UsedConversions
+	}
+	return 0
+}
+
+func (x *Quota) GetValidFrom() string {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return ""
+}
+
+func (x *Quota) GetValidUntil() string {
+	if x != nil {
+		return x.ValidUntil
+	}
+	return ""
+}
+
+func (x *Quota) GetJobTypes() []int32 {
+	if x != nil {
+		return x.JobTypes
+	}
+	return nil
+}
+
+func (x *Quota) GetTargetFormats() []string {
+	if x != nil {
+		return x.TargetFormats
+	}
+	return nil
+}
+
+func (x *Quota) GetIsEnable() bool {
+	if x != nil {
+		return x.IsEnable
+	}
+	return false
+}
+
+// Add Quota Request
+type C2S_AddQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quota *Quota `protobuf:"bytes,10,opt,name=quota,proto3" json:"quota,omitempty"` // Quota to add, the identifier is assigned by the service
+}
+
+func (x *C2S_AddQuotaReq) Reset() {
+	*x = C2S_AddQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_AddQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_AddQuotaReq) ProtoMessage() {}
+
+func (x *C2S_AddQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_AddQuotaReq.ProtoReflect.Descriptor instead.
+func (*C2S_AddQuotaReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *C2S_AddQuotaReq) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// Add Quota Response
+type S2C_AddQuotaRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	QuotaId    string `protobuf:"bytes,30,opt,name=quotaId,proto3" json:"quotaId,omitempty"`        // Identifier of the quota added
+}
+
+func (x *S2C_AddQuotaRpn) Reset() {
+	*x = S2C_AddQuotaRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_AddQuotaRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_AddQuotaRpn) ProtoMessage() {}
+
+func (x *S2C_AddQuotaRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_AddQuotaRpn.ProtoReflect.Descriptor instead.
+func (*S2C_AddQuotaRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *S2C_AddQuotaRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_AddQuotaRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_AddQuotaRpn) GetQuotaId() string {
+	if x != nil {
+		return x.QuotaId
+	}
+	return ""
+}
+
+// Remove Quota Request
+type C2S_RemoveQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuotaId string `protobuf:"bytes,10,opt,name=quotaId,proto3" json:"quotaId,omitempty"` // Identifier of the quota (required)
+}
+
+func (x *C2S_RemoveQuotaReq) Reset() {
+	*x = C2S_RemoveQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_RemoveQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_RemoveQuotaReq) ProtoMessage() {}
+
+func (x *C2S_RemoveQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_RemoveQuotaReq.ProtoReflect.Descriptor instead.
+func (*C2S_RemoveQuotaReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *C2S_RemoveQuotaReq) GetQuotaId() string {
+	if x != nil {
+		return x.QuotaId
+	}
+	return ""
+}
+
+// Remove Quota Response
+type S2C_RemoveQuotaRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+}
+
+func (x *S2C_RemoveQuotaRpn) Reset() {
+	*x = S2C_RemoveQuotaRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_RemoveQuotaRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_RemoveQuotaRpn) ProtoMessage() {}
+
+func (x *S2C_RemoveQuotaRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_RemoveQuotaRpn.ProtoReflect.Descriptor instead.
+func (*S2C_RemoveQuotaRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *S2C_RemoveQuotaRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_RemoveQuotaRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Set Quota Request, replaces the settings of a quota
+type C2S_SetQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Quota *Quota `protobuf:"bytes,10,opt,name=quota,proto3" json:"quota,omitempty"` // Quota to update, identified by its quotaId; usedConversions is kept
+}
+
+func (x *C2S_SetQuotaReq) Reset() {
+	*x = C2S_SetQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_SetQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_SetQuotaReq) ProtoMessage() {}
+
+func (x *C2S_SetQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_SetQuotaReq.ProtoReflect.Descriptor instead.
+func (*C2S_SetQuotaReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *C2S_SetQuotaReq) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// Set Quota Response
+type S2C_SetQuotaRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+}
+
+func (x *S2C_SetQuotaRpn) Reset() {
+	*x = S2C_SetQuotaRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_SetQuotaRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_SetQuotaRpn) ProtoMessage() {}
+
+func (x *S2C_SetQuotaRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_SetQuotaRpn.ProtoReflect.Descriptor instead.
+func (*S2C_SetQuotaRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *S2C_SetQuotaRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_SetQuotaRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Get Quota Request
+type C2S_GetQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	QuotaId string `protobuf:"bytes,10,opt,name=quotaId,proto3" json:"quotaId,omitempty"` // Identifier of the quota (required)
+}
+
+func (x *C2S_GetQuotaReq) Reset() {
+	*x = C2S_GetQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_GetQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_GetQuotaReq) ProtoMessage() {}
+
+func (x *C2S_GetQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_GetQuotaReq.ProtoReflect.Descriptor instead.
+func (*C2S_GetQuotaReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *C2S_GetQuotaReq) GetQuotaId() string {
+	if x != nil {
+		return x.QuotaId
+	}
+	return ""
+}
+
+// Get Quota Response
+type S2C_GetQuotaRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Quota      *Quota `protobuf:"bytes,30,opt,name=quota,proto3" json:"quota,omitempty"`            // Quota found
+}
+
+func (x *S2C_GetQuotaRpn) Reset() {
+	*x = S2C_GetQuotaRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_GetQuotaRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_GetQuotaRpn) ProtoMessage() {}
+
+func (x *S2C_GetQuotaRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_GetQuotaRpn.ProtoReflect.Descriptor instead.
+func (*S2C_GetQuotaRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *S2C_GetQuotaRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_GetQuotaRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_GetQuotaRpn) GetQuota() *Quota {
+	if x != nil {
+		return x.Quota
+	}
+	return nil
+}
+
+// Query Quota Request, empty filters match all quotas
+type C2S_QueryQuotaReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,10,opt,name=tenantId,proto3" json:"tenantId,omitempty"` // Filter by organization
+	UserId   string `protobuf:"bytes,20,opt,name=userId,proto3" json:"userId,omitempty"`     // Filter by user
+	Skip     int32  `protobuf:"varint,30,opt,name=skip,proto3" json:"skip,omitempty"`        // Number of quotas to skip
+	Limit    int32  `protobuf:"varint,40,opt,name=limit,proto3" json:"limit,omitempty"`      // Maximum number of quotas returned, 0 returns all
+}
+
+func (x *C2S_QueryQuotaReq) Reset() {
+	*x = C2S_QueryQuotaReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_QueryQuotaReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_QueryQuotaReq) ProtoMessage() {}
+
+func (x *C2S_QueryQuotaReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_QueryQuotaReq.ProtoReflect.Descriptor instead.
+func (*C2S_QueryQuotaReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *C2S_QueryQuotaReq) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *C2S_QueryQuotaReq) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *C2S_QueryQuotaReq) GetSkip() int32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *C2S_QueryQuotaReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Query Quota Response
+type S2C_QueryQuotaRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32    `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string   `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Quotas     []*Quota `protobuf:"bytes,30,rep,name=quotas,proto3" json:"quotas,omitempty"`          // Quotas found
+}
+
+func (x *S2C_QueryQuotaRpn) Reset() {
+	*x = S2C_QueryQuotaRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_QueryQuotaRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_QueryQuotaRpn) ProtoMessage() {}
+
+func (x *S2C_QueryQuotaRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_QueryQuotaRpn.ProtoReflect.Descriptor instead.
+func (*S2C_QueryQuotaRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *S2C_QueryQuotaRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_QueryQuotaRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_QueryQuotaRpn) GetQuotas() []*Quota {
+	if x != nil {
+		return x.Quotas
+	}
+	return nil
+}
+
+// Get Usage Request, aggregates the usage of finished tasks and exclusive pools per organization and billing period
+type C2S_GetUsageReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId string `protobuf:"bytes,10,opt,name=tenantId,proto3" json:"tenantId,omitempty"` // Organization to report, empty reports all organizations
+	From     string `protobuf:"bytes,20,opt,name=from,proto3" json:"from,omitempty"`         // Start of the reported time range (RFC 3339, required)
+	To       string `protobuf:"bytes,30,opt,name=to,proto3" json:"to,omitempty"`             // End of the reported time range (RFC 3339), empty is now
+	Period   string `protobuf:"bytes,40,opt,name=period,proto3" json:"period,omitempty"`     // Billing period: month (default) or day, periods start at midnight UTC
+}
+
+func (x *C2S_GetUsageReq) Reset() {
+	*x = C2S_GetUsageReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_GetUsageReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_GetUsageReq) ProtoMessage() {}
+
+func (x *C2S_GetUsageReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_GetUsageReq.ProtoReflect.Descriptor instead.
+func (*C2S_GetUsageReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *C2S_GetUsageReq) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *C2S_GetUsageReq) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *C2S_GetUsageReq) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *C2S_GetUsageReq) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+// Get Usage Response
+type S2C_GetUsageRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32    `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string   `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Usages     []*Usage `protobuf:"bytes,30,rep,name=usages,proto3" json:"usages,omitempty"`          // Usage per organization and billing period
+}
+
+func (x *S2C_GetUsageRpn) Reset() {
+	*x = S2C_GetUsageRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_GetUsageRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_GetUsageRpn) ProtoMessage() {}
+
+func (x *S2C_GetUsageRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_GetUsageRpn.ProtoReflect.Descriptor instead.
+func (*S2C_GetUsageRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *S2C_GetUsageRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_GetUsageRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_GetUsageRpn) GetUsages() []*Usage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+// Export Usage Response, the usage as CSV file
+type S2C_ExportUsageRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	FileName   string `protobuf:"bytes,30,opt,name=fileName,proto3" json:"fileName,omitempty"`      // Suggested name of the file
+	Csv        []byte `protobuf:"bytes,40,opt,name=csv,proto3" json:"csv,omitempty"`                // Usage per organization and billing period, one line per usage with a header line
+}
+
+func (x *S2C_ExportUsageRpn) Reset() {
+	*x = S2C_ExportUsageRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_ExportUsageRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_ExportUsageRpn) ProtoMessage() {}
+
+func (x *S2C_ExportUsageRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_ExportUsageRpn.ProtoReflect.Descriptor instead.
+func (*S2C_ExportUsageRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *S2C_ExportUsageRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_ExportUsageRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_ExportUsageRpn) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *S2C_ExportUsageRpn) GetCsv() []byte {
+	if x != nil {
+		return x.Csv
+	}
+	return nil
+}
+
+// Usage of an organization in a billing period
+type Usage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TenantId          string `protobuf:"bytes,10,opt,name=tenantId,proto3" json:"tenantId,omitempty"`                    // Organization
+	Period            string `protobuf:"bytes,20,opt,name=period,proto3" json:"period,omitempty"`                        // Billing period, e.g. 2024-05 or 2024-05-31
+	Jobs              int64  `protobuf:"varint,30,opt,name=jobs,proto3" json:"jobs,omitempty"`                           // Finished tasks
+	FailedJobs        int64  `protobuf:"varint,40,opt,name=failedJobs,proto3" json:"failedJobs,omitempty"`               // Tasks that failed or were cancelled
+	QueueSeconds      int64  `protobuf:"varint,50,opt,name=queueSeconds,proto3" json:"queueSeconds,omitempty"`           // Time the tasks waited before they started
+	ComputeSeconds    int64  `protobuf:"varint,60,opt,name=computeSeconds,proto3" json:"computeSeconds,omitempty"`       // Time the files of the tasks were converting
+	InputBytes        int64  `protobuf:"varint,70,opt,name=inputBytes,proto3" json:"inputBytes,omitempty"`               // Size of the input files of the tasks
+	PoolUptimeSeconds int64  `protobuf:"varint,80,opt,name=poolUptimeSeconds,proto3" json:"poolUptimeSeconds,omitempty"` // Time the exclusive pools of the organization were up
+}
+
+func (x *Usage) Reset() {
+	*x = Usage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Usage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Usage) ProtoMessage() {}
+
+func (x *Usage) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Usage.ProtoReflect.Descriptor instead.
+func (*Usage) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *Usage) GetTenantId() string {
+	if x != nil {
+		return x.TenantId
+	}
+	return ""
+}
+
+func (x *Usage) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *Usage) GetJobs() int64 {
+	if x != nil {
+		return x.Jobs
+	}
+	return 0
+}
+
+func (x *Usage) GetFailedJobs() int64 {
+	if x != nil {
+		return x.FailedJobs
+	}
+	return 0
+}
+
+func (x *Usage) GetQueueSeconds() int64 {
+	if x != nil {
+		return x.QueueSeconds
+	}
+	return 0
+}
+
+func (x *Usage) GetComputeSeconds() int64 {
+	if x != nil {
+		return x.ComputeSeconds
+	}
+	return 0
+}
+
+func (x *Usage) GetInputBytes() int64 {
+	if x != nil {
+		return x.InputBytes
+	}
+	return 0
+}
+
+func (x *Usage) GetPoolUptimeSeconds() int64 {
+	if x != nil {
+		return x.PoolUptimeSeconds
+	}
+	return 0
+}
+
+// Set Webhook Request, status changes of the tasks of the organization are posted to the URL.
+// Payloads are signed with HMAC-SHA256 of "<timestamp>.<body>" in the X-Transform-Signature header,
+// the timestamp is sent in the X-Transform-Timestamp header.
+type C2S_SetWebhookReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url      string `protobuf:"bytes,10,opt,name=url,proto3" json:"url,omitempty"`            // http or https URL receiving the payloads (required)
+	Secret   string `protobuf:"bytes,20,opt,name=secret,proto3" json:"secret,omitempty"`      // Secret signing the payloads, empty generates a new secret
+	Disabled bool   `protobuf:"varint,30,opt,name=disabled,proto3" json:"disabled,omitempty"` // Stops the deliveries without removing the webhook
+}
+
+func (x *C2S_SetWebhookReq) Reset() {
+	*x = C2S_SetWebhookReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_SetWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_SetWebhookReq) ProtoMessage() {}
+
+func (x *C2S_SetWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_SetWebhookReq.ProtoReflect.Descriptor instead.
+func (*C2S_SetWebhookReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *C2S_SetWebhookReq) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *C2S_SetWebhookReq) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *C2S_SetWebhookReq) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// Set Webhook Response
+type S2C_SetWebhookRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Secret     string `protobuf:"bytes,30,opt,name=secret,proto3" json:"secret,omitempty"`          // Secret signing the payloads
+}
+
+func (x *S2C_SetWebhookRpn) Reset() {
+	*x = S2C_SetWebhookRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_SetWebhookRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_SetWebhookRpn) ProtoMessage() {}
+
+func (x *S2C_SetWebhookRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_SetWebhookRpn.ProtoReflect.Descriptor instead.
+func (*S2C_SetWebhookRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *S2C_SetWebhookRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_SetWebhookRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_SetWebhookRpn) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+// Remove Webhook Request, removes the webhook of the organization of the caller
+type C2S_RemoveWebhookReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *C2S_RemoveWebhookReq) Reset() {
+	*x = C2S_RemoveWebhookReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_RemoveWebhookReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_RemoveWebhookReq) ProtoMessage() {}
+
+func (x *C2S_RemoveWebhookReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_RemoveWebhookReq.ProtoReflect.Descriptor instead.
+func (*C2S_RemoveWebhookReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{86}
+}
+
+// Remove Webhook Response
+type S2C_RemoveWebhookRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32  `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+}
+
+func (x *S2C_RemoveWebhookRpn) Reset() {
+	*x = S2C_RemoveWebhookRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_RemoveWebhookRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_RemoveWebhookRpn) ProtoMessage() {}
+
+func (x *S2C_RemoveWebhookRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_RemoveWebhookRpn.ProtoReflect.Descriptor instead.
+func (*S2C_RemoveWebhookRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *S2C_RemoveWebhookRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_RemoveWebhookRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Query Webhook Deliveries Request, returns the newest deliveries of the organization of the caller first
+type C2S_QueryWebhookDeliveriesReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId  string `protobuf:"bytes,10,opt,name=jobId,proto3" json:"jobId,omitempty"`   // Filter by task
+	Status string `protobuf:"bytes,20,opt,name=status,proto3" json:"status,omitempty"` // Filter by delivery status: Pending, Delivered or Failed
+	Skip   int32  `protobuf:"varint,30,opt,name=skip,proto3" json:"skip,omitempty"`    // Number of deliveries to skip
+	Limit  int32  `protobuf:"varint,40,opt,name=limit,proto3" json:"limit,omitempty"`  // Maximum number of deliveries returned, 0 returns 100
+}
+
+func (x *C2S_QueryWebhookDeliveriesReq) Reset() {
+	*x = C2S_QueryWebhookDeliveriesReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *C2S_QueryWebhookDeliveriesReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*C2S_QueryWebhookDeliveriesReq) ProtoMessage() {}
+
+func (x *C2S_QueryWebhookDeliveriesReq) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use C2S_QueryWebhookDeliveriesReq.ProtoReflect.Descriptor instead.
+func (*C2S_QueryWebhookDeliveriesReq) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *C2S_QueryWebhookDeliveriesReq) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *C2S_QueryWebhookDeliveriesReq) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *C2S_QueryWebhookDeliveriesReq) GetSkip() int32 {
+	if x != nil {
+		return x.Skip
+	}
+	return 0
+}
+
+func (x *C2S_QueryWebhookDeliveriesReq) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// Query Webhook Deliveries Response
+type S2C_QueryWebhookDeliveriesRpn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StatusCode int32              `protobuf:"varint,10,opt,name=StatusCode,proto3" json:"StatusCode,omitempty"` // Code denoting the service exectuion
+	Message    string             `protobuf:"bytes,20,opt,name=Message,proto3" json:"Message,omitempty"`        // Message from the service after execution
+	Deliveries []*WebhookDelivery `protobuf:"bytes,30,rep,name=deliveries,proto3" json:"deliveries,omitempty"`  // Deliveries found
+}
+
+func (x *S2C_QueryWebhookDeliveriesRpn) Reset() {
+	*x = S2C_QueryWebhookDeliveriesRpn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *S2C_QueryWebhookDeliveriesRpn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*S2C_QueryWebhookDeliveriesRpn) ProtoMessage() {}
+
+func (x *S2C_QueryWebhookDeliveriesRpn) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use S2C_QueryWebhookDeliveriesRpn.ProtoReflect.Descriptor instead.
+func (*S2C_QueryWebhookDeliveriesRpn) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *S2C_QueryWebhookDeliveriesRpn) GetStatusCode() int32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *S2C_QueryWebhookDeliveriesRpn) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *S2C_QueryWebhookDeliveriesRpn) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// Delivery of a status change of a task to a webhook
+type WebhookDelivery struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DeliveryId    string `protobuf:"bytes,10,opt,name=deliveryId,proto3" json:"deliveryId,omitempty"`        // Identifier of the delivery, sent in the X-Transform-Delivery header
+	JobId         string `protobuf:"bytes,20,opt,name=jobId,proto3" json:"jobId,omitempty"`                  // Identifier of the task
+	Url           string `protobuf:"bytes,30,opt,name=url,proto3" json:"url,omitempty"`                      // URL the payload is posted to
+	JobStatus     string `protobuf:"bytes,40,opt,name=jobStatus,proto3" json:"jobStatus,omitempty"`          // Status of the task in the payload
+	Status        string `protobuf:"bytes,50,opt,name=status,proto3" json:"status,omitempty"`                // Status of the delivery: Pending, Delivered or Failed
+	Attempts      int32  `protobuf:"varint,60,opt,name=attempts,proto3" json:"attempts,omitempty"`           // Number of delivery attempts
+	ResponseCode  int32  `protobuf:"varint,70,opt,name=responseCode,proto3" json:"responseCode,omitempty"`   // HTTP status code of the last attempt, 0 if no response was received
+	Error         string `protobuf:"bytes,80,opt,name=error,proto3" json:"error,omitempty"`                  // Error of the last attempt
+	CreatedAt     string `protobuf:"bytes,90,opt,name=createdAt,proto3" json:"createdAt,omitempty"`          // Time when the delivery was created
+	NextAttemptAt string `protobuf:"bytes,100,opt,name=nextAttemptAt,proto3" json:"nextAttemptAt,omitempty"` // Time of the next attempt of a pending delivery
+	DeliveredAt   string `protobuf:"bytes,110,opt,name=deliveredAt,proto3" json:"deliveredAt,omitempty"`     // Time when the payload was delivered
+	Payload       string `protobuf:"bytes,120,opt,name=payload,proto3" json:"payload,omitempty"`             // JSON payload posted to the URL
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_TransformService2_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_TransformService2_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_TransformService2_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *WebhookDelivery) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetJobId() string {
+	if x != nil {
+		return x.JobId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetJobStatus() string {
+	if x != nil {
+		return x.JobStatus
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetNextAttemptAt() string {
+	if x != nil {
+		return x.NextAttemptAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetDeliveredAt() string {
+	if x != nil {
+		return x.DeliveredAt
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+var File_TransformService2_proto protoreflect.FileDescriptor
+
+var file_TransformService2_proto_rawDesc = []byte{
+	0x0a, 0x17, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x1a, 0x1c, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x6e, 0x0a, 0x18, 0x43, 0x32,
+	0x53, 0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1a,
+	0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x41, 0x73,
+	0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x49, 0x64, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x41, 0x73, 0x63, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x49, 0x64, 0x22, 0x70, 0x0a, 0x18, 0x43, 0x32,
+	0x53, 0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xa8, 0x03, 0x0a,
+	0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d,
+	0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x72, 0x6c, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x28, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18,
+	0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x50, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x40, 0x0a, 0x0b,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x5a, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63,
+	0x79, 0x52, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x37,
+	0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x9c, 0x02, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65,
+	0x54, 0x6f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x6f,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x13,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x13, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x36,
+	0x0a, 0x16, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x16,
+	0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x54, 0x6f, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62,
+	0x65, 0x61, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x10, 0x48, 0x65, 0x61, 0x72, 0x74, 0x62, 0x65, 0x61, 0x74, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x18, 0x32, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x50, 0x65, 0x72,
+	0x4d, 0x42, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x50, 0x65, 0x72, 0x4d, 0x42, 0x22, 0xed, 0x01, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x4d, 0x61, 0x78, 0x41, 0x74, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x4d, 0x61, 0x78,
+	0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x49, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66,
+	0x66, 0x43, 0x6f, 0x65, 0x66, 0x66, 0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x12, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x43, 0x6f, 0x65, 0x66, 0x66,
+	0x69, 0x63, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75,
+	0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x4d, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x11, 0x4d, 0x61, 0x78, 0x69, 0x6d, 0x75, 0x6d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x4d, 0x73, 0x12, 0x30, 0x0a, 0x13, 0x52, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c,
+	0x65, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x32, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x13, 0x52, 0x65, 0x74, 0x72, 0x79, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x61, 0x74, 0x65,
+	0x67, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x22, 0x6f, 0x0a, 0x15, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x36, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x4a,
+	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x22, 0x93, 0x03, 0x0a, 0x15, 0x43, 0x32, 0x53, 0x5f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f,
+	0x74, 0x12, 0x28, 0x0a, 0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4a, 0x6f, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x3c, 0x0a, 0x19, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x05, 0x52, 0x19,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x55, 0x72, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x63, 0x53,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x32, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x12, 0x26, 0x0a, 0x0e, 0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x4a, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x46, 0x20, 0x03, 0x28, 0x09, 0x52, 0x15, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x50, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73,
+	0x6b, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9c, 0x02,
+	0x0a, 0x14, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
+	0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x28,
+	0x0a, 0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x49, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x4e, 0x61, 0x6d, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x4e, 0x61,
+	0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x54, 0x6f, 0x74, 0x61,
+	0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x14, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x50, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6b,
+	0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x5f, 0x0a, 0x14,
+	0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52,
+	0x70, 0x6e, 0x5f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x73,
+	0x65, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f,
+	0x62, 0x53, 0x65, 0x74, 0x52, 0x07, 0x4a, 0x6f, 0x62, 0x73, 0x65, 0x74, 0x73, 0x22, 0x96, 0x01,
+	0x0a, 0x06, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49,
+	0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f,
+	0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0x32, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x5b, 0x0a, 0x12, 0x43, 0x32,
+	0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x4a, 0x6f, 0x62, 0x73, 0x65, 0x74, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52,
+	0x06, 0x4a, 0x6f, 0x62, 0x73, 0x65, 0x74, 0x22, 0x4d, 0x0a, 0x1d, 0x43, 0x32, 0x53, 0x5f, 0x53,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x41, 0x72, 0x67, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x41, 0x72, 0x67,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4d, 0x0a, 0x1d, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x86, 0x01, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64,
+	0x64, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x22, 0xa2,
+	0x01, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x05, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78,
+	0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x28, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x33, 0x0a, 0x15, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x15, 0x43, 0x32, 0x53, 0x5f,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x5e, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
+	0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x5e, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
+	0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x49,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x60, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50,
+	0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x6f,
+	0x6c, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x08, 0x50, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x88, 0x01, 0x01, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x50, 0x6f, 0x6f, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x33,
+	0x0a, 0x04, 0x50, 0x6f, 0x6f, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
+	0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x04, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x1d, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x01, 0x52, 0x04, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x43, 0x6f, 0x64, 0x65,
+	0x22, 0x8a, 0x03, 0x0a, 0x1a, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12,
+	0x32, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49,
+	0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x52,
+	0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x4e, 0x61,
+	0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x1e, 0x0a,
+	0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x4e, 0x61, 0x6d, 0x65, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x26, 0x0a,
+	0x0e, 0x49, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x49, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x34, 0x0a, 0x15, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72,
+	0x61, 0x74, 0x65, 0x67, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x53,
+	0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x18, 0x32, 0x20, 0x01, 0x28, 0x05, 0x52, 0x12, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x43,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x3c, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x50, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73,
+	0x6b, 0x69, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x67, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x64, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0xb0, 0x01,
+	0x0a, 0x1a, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x17, 0x0a, 0x04,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x43, 0x6f,
+	0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x0d, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f,
+	0x43, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0xb6, 0x04, 0x0a, 0x0c, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d,
+	0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61,
+	0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x49,
+	0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x28, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x49,
+	0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6c, 0x69,
+	0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x32, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67,
+	0x79, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x18, 0x46, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x18, 0x50,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x18, 0x5a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x65, 0x74, 0x12, 0x5b, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c,
+	0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x64, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x70, 0x75, 0x18, 0x6e, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x07, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x70, 0x75, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x6c,
+	0x6f, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x78, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0c, 0x53, 0x6c, 0x6f, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x1a, 0x69,
+	0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x3c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x57, 0x0a, 0x13, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x22,
+	0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a,
+	0x0f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53,
+	0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69,
+	0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x53,
+	0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x46,
+	0x69, 0x78, 0x65, 0x64, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x46, 0x69, 0x78, 0x65,
+	0x64, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b,
+	0x53, 0x65, 0x74, 0x18, 0x46, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x50, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x6c, 0x6f, 0x74, 0x43,
+	0x70, 0x75, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x70,
+	0x75, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x6c, 0x6f, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x42, 0x18, 0x64, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x53, 0x6c, 0x6f, 0x74, 0x4d, 0x65, 0x6d,
+	0x6f, 0x72, 0x79, 0x4d, 0x42, 0x22, 0x9a, 0x01, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64,
+	0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e,
+	0x5f, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f,
+	0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61,
+	0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e,
+	0x61, 0x6d, 0x65, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x35, 0x0a, 0x1b, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x57, 0x0a, 0x1b, 0x43, 0x32, 0x53,
+	0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
+	0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0xc8, 0x03, 0x0a, 0x18, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12,
+	0x26, 0x0a, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x53,
+	0x70, 0x61, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4e, 0x61, 0x6d, 0x65,
+	0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x73, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67,
+	0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0f,
+	0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x53, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x22, 0x0a, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x32, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x53, 0x63, 0x61, 0x6c, 0x69, 0x6e, 0x67, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x51, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x18, 0x46, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x05, 0x46, 0x69, 0x78, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65, 0x74, 0x18, 0x50, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x0e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x65,
+	0x74, 0x12, 0x4c, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x5a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4f, 0x66, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x0d, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x70, 0x75, 0x18, 0x64, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x07, 0x53, 0x6c, 0x6f, 0x74, 0x43, 0x70, 0x75, 0x12, 0x22, 0x0a, 0x0c, 0x53, 0x6c, 0x6f,
+	0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0c, 0x53, 0x6c, 0x6f, 0x74, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d, 0x42, 0x22, 0x7c, 0x0a,
+	0x18, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x49,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x1a, 0x43,
+	0x32, 0x53, 0x5f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x6f,
+	0x6c, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49,
+	0x64, 0x22, 0x74, 0x0a, 0x1a, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x33, 0x0a, 0x19, 0x43, 0x32, 0x53, 0x5f, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x5f, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x6f, 0x6f, 0x6c, 0x49, 0x64, 0x22, 0x7b, 0x0a, 0x19,
+	0x43, 0x32, 0x53, 0x5f, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x55, 0x70, 0x74, 0x69,
+	0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x96, 0x03, 0x0a, 0x13, 0x43, 0x32,
+	0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f,
+	0x74, 0x12, 0x30, 0x0a, 0x13, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13,
+	0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x53, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x55, 0x72, 0x6c, 0x12,
+	0x1a, 0x0a, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53,
+	0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53,
+	0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x4a, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x4a, 0x65, 0x53, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61,
+	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
+	0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x40, 0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
+	0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0b, 0x52, 0x65,
+	0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x37, 0x0a, 0x08, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x73, 0x22, 0x33, 0x0a, 0x13, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x13, 0x43, 0x32, 0x53, 0x5f,
+	0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12,
+	0x34, 0x0a, 0x07, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x4a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
+	0x6d, 0x0a, 0x13, 0x53, 0x32, 0x43, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70,
+	0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70,
+	0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+	0x64, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x36,
+	0x0a, 0x16, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x22, 0x52, 0x0a, 0x16, 0x53, 0x32, 0x43, 0x5f, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfc, 0x02, 0x0a, 0x13, 0x43,
+	0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71,
+	0x5f, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x52, 0x65, 0x53, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x28,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x53, 0x63, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x4a, 0x65, 0x53, 0x63, 0x72, 0x69, 0x70, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x46, 0x69,
+	0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x3c, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0f, 0x46, 0x69, 0x78, 0x65, 0x64, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65, 0x75,
+	0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x54, 0x61, 0x73, 0x6b, 0x51, 0x75, 0x65,
+	0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x50,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x66, 0x6c, 0x6f, 0x77, 0x12, 0x40,
+	0x0a, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x5a, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0b, 0x52, 0x65, 0x74, 0x72, 0x79, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x12, 0x37, 0x0a, 0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x18, 0x64, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x52,
+	0x08, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x73, 0x22, 0x6d, 0x0a, 0x13, 0x53, 0x32, 0x43,
+	0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74,
+	0x12, 0x1c, 0x0a, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xe2, 0x02, 0x0a, 0x10, 0x43, 0x32, 0x53,
+	0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a,
+	0x07, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07,
+	0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x66,
+	0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70,
+	0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4b, 0x65, 0x79, 0x12,
+	0x24, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73,
+	0x18, 0x3c, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x6f,
+	0x72, 0x6d, 0x61, 0x74, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x55, 0x72, 0x6c, 0x18, 0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42,
+	0x65, 0x66, 0x6f, 0x72, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x6f,
+	0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22, 0x62, 0x0a,
+	0x10, 0x53, 0x32, 0x43, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x70,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x4a,
+	0x6f, 0x62, 0x49, 0x44, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4a, 0x6f, 0x62, 0x49,
+	0x44, 0x22, 0x70, 0x0a, 0x11, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x12, 0x37, 0x0a, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x18, 0x0a,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18,
+	0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x61, 0x6c, 0x6c, 0x4f, 0x72, 0x4e, 0x6f, 0x74, 0x68,
+	0x69, 0x6e, 0x67, 0x22, 0x8b, 0x01, 0x0a, 0x11, 0x53, 0x32, 0x43, 0x5f, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x1e,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x22, 0x77, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x1e,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x29, 0x0a, 0x11, 0x43, 0x32,
+	0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x12,
+	0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x7d, 0x0a, 0x11, 0x53, 0x32, 0x43, 0x5f, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x61, 0x22, 0x40, 0x0a, 0x10, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x61, 0x6e, 0x63,
+	0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4c, 0x0a, 0x10, 0x53, 0x32, 0x43, 0x5f, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x3f, 0x0a, 0x0f, 0x43, 0x32, 0x53, 0x5f, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x4b, 0x0a, 0x0f, 0x53, 0x32, 0x43, 0x5f, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x22, 0x28, 0x0a, 0x10, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x4c, 0x0a, 0x10,
+	0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x27, 0x0a, 0x0f, 0x43, 0x32,
+	0x53, 0x5f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f,
+	0x62, 0x49, 0x64, 0x22, 0x61, 0x0a, 0x0f, 0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x74, 0x72, 0x79,
+	0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x4a, 0x6f, 0x62, 0x49, 0x44, 0x22, 0x2e, 0x0a, 0x16, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x22, 0x87, 0x01, 0x0a, 0x16, 0x53, 0x32, 0x43, 0x5f, 0x47,
+	0x65, 0x74, 0x4a, 0x6f, 0x62, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x70,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f,
+	0x62, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x22, 0xa6, 0x03, 0x0a, 0x0c, 0x4a, 0x6f, 0x62, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x51,
+	0x75, 0x65, 0x75, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x73, 0x6b,
+	0x51, 0x75, 0x65, 0x75, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x71, 0x75, 0x65, 0x75, 0x65, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a,
+	0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x3c, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x2c, 0x0a, 0x11, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x2a, 0x0a, 0x10, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x41, 0x74, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x72, 0x65, 0x64, 0x69,
+	0x63, 0x74, 0x65, 0x64, 0x53, 0x74, 0x61, 0x72, 0x74, 0x41, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x70,
+	0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74,
+	0x18, 0x5a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x74, 0x65,
+	0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75,
+	0x43, 0x6f, 0x72, 0x65, 0x73, 0x18, 0x64, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x63, 0x70, 0x75,
+	0x43, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x42, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x4d,
+	0x42, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x53, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x18, 0x78, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x43, 0x32, 0x53,
+	0x5f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05,
+	0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x18, 0x14, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x22, 0x2c, 0x0a, 0x10,
+	0x43, 0x32, 0x53, 0x5f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
+	0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x66, 0x72, 0x6f, 0x6d, 0x53, 0x65, 0x71, 0x22, 0xfb, 0x01, 0x0a, 0x08, 0x4a,
+	0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62,
+	0x49, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x32,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x18, 0x46, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x05, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67,
+	0x6f, 0x72, 0x79, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x22, 0x73, 0x0a, 0x0f, 0x43, 0x32, 0x53, 0x5f,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x49,
+	0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x7b, 0x0a,
+	0x0f, 0x53, 0x32, 0x43, 0x5f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x70, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x6a, 0x6f,
+	0x62, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x22, 0xd9, 0x03, 0x0a, 0x07, 0x4a,
+	0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07,
+	0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a,
+	0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18,
+	0x46, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x35, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x50, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x66, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x74, 0x72, 0x79, 0x4f, 0x66, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x69, 0x6f, 0x72,
+	0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x18, 0x78, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x42, 0x65, 0x66, 0x6f, 0x72,
+	0x65, 0x12, 0x23, 0x0a, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x18, 0x82, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x6f, 0x6e, 0x53, 0x63,
+	0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x1f, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x49, 0x64, 0x18, 0x8c, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68,
+	0x65, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x52,
+	0x75, 0x6e, 0x41, 0x74, 0x18, 0x96, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x78,
+	0x74, 0x52, 0x75, 0x6e, 0x41, 0x74, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x18, 0x32, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x61, 0x74, 0x65, 0x67, 0x6f, 0x72, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x3c, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x22, 0xcf, 0x02, 0x0a, 0x05, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x49, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x49, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x12, 0x32, 0x0a, 0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x14, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x32, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x75, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x18, 0x3c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x18, 0x46, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x12, 0x1a, 0x0a,
+	0x08, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x50, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x08, 0x6a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x18, 0x5a, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x64, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x08, 0x69, 0x73, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x43,
+	0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x2e,
+	0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x32, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x65,
+	0x0a, 0x0f, 0x53, 0x32, 0x43, 0x5f, 0x41, 0x64, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x70,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x49, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x12, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x49, 0x64, 0x22, 0x4e, 0x0a, 0x12, 0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x2e, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x0f, 0x53, 0x32, 0x43, 0x5f,
+	0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x2b, 0x0a, 0x0f, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74,
+	0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x71, 0x75, 0x6f, 0x74, 0x61,
+	0x49, 0x64, 0x22, 0x7b, 0x0a, 0x0f, 0x53, 0x32, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x2e, 0x0a, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x32, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x05, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22,
+	0x71, 0x0a, 0x11, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70,
+	0x18, 0x1e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x22, 0x7f, 0x0a, 0x11, 0x53, 0x32, 0x43, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x30, 0x0a, 0x06, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x06, 0x71, 0x75, 0x6f,
+	0x74, 0x61, 0x73, 0x22, 0x69, 0x0a, 0x0f, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x1e, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x22, 0x7d,
+	0x0a, 0x0f, 0x53, 0x32, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x70,
+	0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x30, 0x0a, 0x06, 0x75,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x22, 0x7c, 0x0a,
+	0x12, 0x53, 0x32, 0x43, 0x5f, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43,
+	0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x76,
+	0x18, 0x28, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x03, 0x63, 0x73, 0x76, 0x22, 0x89, 0x02, 0x0a, 0x05,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6a, 0x6f, 0x62,
+	0x73, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x6a, 0x6f, 0x62, 0x73, 0x12, 0x1e, 0x0a,
+	0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0a, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x32, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x71, 0x75, 0x65, 0x75, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x75,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x46, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x70, 0x6f, 0x6f,
+	0x6c, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x50,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x70, 0x6f, 0x6f, 0x6c, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x11, 0x43, 0x32, 0x53, 0x5f, 0x53,
+	0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x22, 0x65, 0x0a, 0x11, 0x53, 0x32, 0x43, 0x5f, 0x53, 0x65, 0x74, 0x57, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x32, 0x53,
+	0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65,
+	0x71, 0x22, 0x50, 0x0a, 0x14, 0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x70, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x22, 0x77, 0x0a, 0x1d, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x73, 0x6b, 0x69, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x28, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x9d, 0x01, 0x0a,
+	0x1d, 0x53, 0x32, 0x43, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x70, 0x6e, 0x12, 0x1e,
+	0x0a, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x42, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x18, 0x1e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x22, 0xe5, 0x02, 0x0a,
+	0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x1e, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x6a, 0x6f, 0x62, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6a, 0x6f, 0x62,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x32, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18, 0x3c, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x46, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x0c, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x50, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x18, 0x5a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x41, 0x74, 0x18, 0x64, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x41,
+	0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x18, 0x6e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x78, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x32, 0xdf, 0x07, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x56, 0x32, 0x12, 0x55, 0x0a, 0x09, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x12, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x12, 0x5a, 0x0a, 0x0a, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x24, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
+	0x53, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f,
+	0x62, 0x73, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a, 0x24, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
+	0x53, 0x32, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x70,
+	0x6e, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x09, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62,
+	0x12, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x4a,
+	0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x43, 0x61,
+	0x6e, 0x63, 0x65, 0x6c, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x08,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x12, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
+	0x5f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
+	0x2e, 0x53, 0x32, 0x43, 0x5f, 0x50, 0x61, 0x75, 0x73, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e,
+	0x22, 0x00, 0x12, 0x57, 0x0a, 0x09, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a, 0x6f, 0x62, 0x12,
+	0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x4a, 0x6f,
+	0x62, 0x52, 0x65, 0x71, 0x1a, 0x23, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x08, 0x52,
+	0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x12, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f,
+	0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
+	0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x74, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x52, 0x70, 0x6e, 0x22,
+	0x00, 0x12, 0x69, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x51, 0x75, 0x65, 0x75, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x51, 0x75, 0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x1a,
+	0x29, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x51, 0x75,
+	0x65, 0x75, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x08,
+	0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x12, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
+	0x5f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x52, 0x65, 0x71, 0x1a, 0x1b, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
+	0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01, 0x12, 0x51, 0x0a,
+	0x09, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x23, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43,
+	0x32, 0x53, 0x5f, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x1a,
+	0x1b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x4a, 0x6f, 0x62, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x54, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x22, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
+	0x2e, 0x43, 0x32, 0x53, 0x5f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71,
+	0x1a, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x62,
+	0x73, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x32, 0xce, 0x03, 0x0a, 0x0f, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x54, 0x0a, 0x08, 0x41, 0x64,
+	0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41,
+	0x64, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53,
+	0x32, 0x43, 0x5f, 0x41, 0x64, 0x64, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x70, 0x6e, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12,
+	0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x12,
+	0x54, 0x0a, 0x08, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x22, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
+	0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a,
+	0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x53, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x52, 0x70, 0x6e, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f, 0x74,
+	0x61, 0x12, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x47, 0x65,
+	0x74, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0a, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x24, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
+	0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x52, 0x65, 0x71, 0x1a,
+	0x24, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x51, 0x75, 0x6f,
+	0x74, 0x61, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x32, 0xf3, 0x01, 0x0a, 0x10, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x6b, 0x0a, 0x0f,
+	0x53, 0x65, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2b, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32,
+	0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x72, 0x0a, 0x16, 0x53, 0x65, 0x74,
+	0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x54,
+	0x61, 0x6e, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x5f, 0x74,
+	0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x54, 0x61, 0x6e, 0x65,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x32, 0xc6, 0x08,
+	0x0a, 0x0d, 0x4a, 0x6f, 0x62, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x5c, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x26, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x41, 0x64,
+	0x64, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x65, 0x0a,
+	0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x29, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32,
+	0x43, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x70, 0x6e, 0x5f, 0x74, 0x12, 0x5c, 0x0a, 0x0a, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x26, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53,
+	0x32, 0x43, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e,
+	0x5f, 0x74, 0x12, 0x5c, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x26, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
+	0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x70, 0x6e, 0x5f, 0x74,
+	0x12, 0x62, 0x0a, 0x0c, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x28, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f,
+	0x62, 0x54, 0x79, 0x70, 0x65, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x28, 0x2e, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43,
+	0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x54, 0x79, 0x70, 0x65, 0x52,
+	0x70, 0x6e, 0x5f, 0x74, 0x12, 0x59, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x65,
+	0x74, 0x12, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
+	0x5f, 0x41, 0x64, 0x64, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12,
+	0x62, 0x0a, 0x0c, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12,
+	0x28, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f,
+	0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x28, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
+	0x53, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70,
+	0x6e, 0x5f, 0x74, 0x12, 0x59, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74,
+	0x12, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53,
+	0x65, 0x74, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f,
+	0x53, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x59,
+	0x0a, 0x09, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x25, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e,
+	0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x5f, 0x74, 0x1a, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x5f, 0x0a, 0x0b, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x12, 0x27, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
+	0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x65, 0x71, 0x5f,
+	0x74, 0x1a, 0x27, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79, 0x4a,
+	0x6f, 0x62, 0x53, 0x65, 0x74, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x7a, 0x0a, 0x14, 0x53, 0x65,
+	0x74, 0x4a, 0x6f, 0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x30, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74, 0x4a, 0x6f,
+	0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x5f, 0x74, 0x1a, 0x30, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74,
+	0x4a, 0x6f, 0x62, 0x46, 0x69, 0x78, 0x65, 0x64, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x32, 0xab, 0x06, 0x0a, 0x16, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x12, 0x6b, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f,
+	0x74, 0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x41, 0x64, 0x64, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x74,
+	0x0a, 0x12, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2e, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x52, 0x65, 0x6d,
+	0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x70, 0x6e, 0x5f, 0x74, 0x12, 0x6b, 0x0a, 0x0f, 0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f,
+	0x53, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f,
+	0x74, 0x12, 0x6b, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x5f,
+	0x74, 0x1a, 0x2b, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f, 0x74, 0x12, 0x71,
+	0x0a, 0x11, 0x51, 0x75, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
+	0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72,
+	0x79, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x65, 0x71,
+	0x5f, 0x74, 0x1a, 0x2d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75, 0x65, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x70, 0x6e, 0x5f,
+	0x74, 0x12, 0x71, 0x0a, 0x11, 0x53, 0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c,
+	0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x70, 0x6e, 0x5f, 0x74, 0x12, 0x6e, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x2c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
+	0x5f, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f,
+	0x6c, 0x52, 0x65, 0x71, 0x5f, 0x74, 0x1a, 0x2c, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x53,
+	0x74, 0x6f, 0x70, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x6f, 0x6f, 0x6c, 0x52,
+	0x70, 0x6e, 0x5f, 0x74, 0x32, 0xd4, 0x02, 0x0a, 0x11, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x5a, 0x0a, 0x0a, 0x53, 0x65,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x24, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53,
+	0x5f, 0x53, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71, 0x1a, 0x24,
+	0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x53, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x0d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x27, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x65, 0x71,
+	0x1a, 0x27, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x57,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x12, 0x7e, 0x0a, 0x16, 0x51,
+	0x75, 0x65, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72,
+	0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x30, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66,
+	0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x32, 0xc1, 0x01, 0x0a, 0x0d,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a,
+	0x08, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32,
+	0x53, 0x5f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x22, 0x2e,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x70,
+	0x6e, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x0b, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x22, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f, 0x72, 0x6d, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x43, 0x32, 0x53, 0x5f, 0x47, 0x65, 0x74, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x1a, 0x25, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x6f,
+	0x72, 0x6d, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x32, 0x2e, 0x53, 0x32, 0x43, 0x5f, 0x45,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x70, 0x6e, 0x22, 0x00, 0x42,
+	0x0d, 0x5a, 0x0b, 0x2e, 0x2f, 0x3b, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_TransformService2_proto_rawDescOnce sync.Once
+	file_TransformService2_proto_rawDescData = file_TransformService2_proto_rawDesc
+)
+
+func file_TransformService2_proto_rawDescGZIP() []byte {
+	file_TransformService2_proto_rawDescOnce.Do(func() {
+		file_TransformService2_proto_rawDescData = protoimpl.X.CompressGZIP(file_TransformService2_proto_rawDescData)
+	})
+	return file_TransformService2_proto_rawDescData
+}
+
+var file_TransformService2_proto_msgTypes = make([]protoimpl.MessageInfo, 92)
+var file_TransformService2_proto_goTypes = []interface{}{
+	(*C2S_SetTanentConfigReqT)(nil),       // 0: TransformService2.C2S_SetTanentConfigReq_t
+	(*C2S_SetTanentConfigRpnT)(nil),       // 1: TransformService2.C2S_SetTanentConfigRpn_t
+	(*JobType)(nil),                       // 2: TransformService2.JobType
+	(*Timeouts)(nil),                      // 3: TransformService2.Timeouts
+	(*RetryPolicy)(nil),                   // 4: TransformService2.RetryPolicy
+	(*C2S_QueryJobTypeRpnT)(nil),          // 5: TransformService2.C2S_QueryJobTypeRpn_t
+	(*C2S_QueryJobTypeReqT)(nil),          // 6: TransformService2.C2S_QueryJobTypeReq_t
+	(*C2S_QueryJobSetReqT)(nil),           // 7: TransformService2.C2S_QueryJobSetReq_t
+	(*C2S_QueryJobSetRpnT)(nil),           // 8: TransformService2.C2S_QueryJobSetRpn_t
+	(*JobSet)(nil),                        // 9: TransformService2.JobSet
+	(*C2S_GetJobSetReqT)(nil),             // 10: TransformService2.C2S_GetJobSetReq_t
+	(*C2S_GetJobSetRpnT)(nil),             // 11: TransformService2.C2S_GetJobSetRpn_t
+	(*C2S_SetJobFixedArgumentsReqT)(nil),  // 12: TransformService2.C2S_SetJobFixedArgumentsReq_t
+	(*C2S_SetJobFixedArgumentsRpnT)(nil),  // 13: TransformService2.C2S_SetJobFixedArgumentsRpn_t
+	(*C2S_AddJobSetReqT)(nil),             // 14: TransformService2.C2S_AddJobSetReq_t
+	(*C2S_SetJobSetReqT)(nil),             // 15: TransformService2.C2S_SetJobSetReq_t
+	(*C2S_RemoveJobSetReqT)(nil),          // 16: TransformService2.C2S_RemoveJobSetReq_t
+	(*C2S_RemoveJobSetRpnT)(nil),          // 17: TransformService2.C2S_RemoveJobSetRpn_t
+	(*C2S_SetJobSetRpnT)(nil),             // 18: TransformService2.C2S_SetJobSetRpn_t
+	(*C2S_AddJobSetRpnT)(nil),             // 19: TransformService2.C2S_AddJobSetRpn_t
+	(*C2S_GetResourcePoolReqT)(nil),       // 20: TransformService2.C2S_GetResourcePoolReq_t
+	(*C2S_GetResourcePoolRpnT)(nil),       // 21: TransformService2.C2S_GetResourcePoolRpn_t
+	(*C2S_QueryResourcePoolReqT)(nil),     // 22: TransformService2.C2S_QueryResourcePoolReq_t
+	(*C2S_QueryResourcePoolRpnT)(nil),     // 23: TransformService2.C2S_QueryResourcePoolRpn_t
+	(*ResourcePool)(nil),                  // 24: TransformService2.ResourcePool
+	(*ResourceLimitOfTask)(nil),           // 25: TransformService2.ResourceLimitOfTask
+	(*C2S_AddResourcePoolReqT)(nil),       // 26: TransformService2.C2S_AddResourcePoolReq_t
+	(*C2S_AddResourcePoolRpnT)(nil),       // 27: TransformService2.C2S_AddResourcePoolRpn_t
+	(*C2S_RemoveResourcePoolReqT)(nil),    // 28: TransformService2.C2S_RemoveResourcePoolReq_t
+	(*C2S_RemoveResourcePoolRpnT)(nil),    // 29: TransformService2.C2S_RemoveResourcePoolRpn_t
+	(*C2S_SetResourcePoolReqT)(nil),       // 30: TransformService2.C2S_SetResourcePoolReq_t
+	(*C2S_SetResourcePoolRpnT)(nil),       // 31: TransformService2.C2S_SetResourcePoolRpn_t
+	(*C2S_StartResourcePoolReqT)(nil),     // 32: TransformService2.C2S_StartResourcePoolReq_t
+	(*C2S_StartResourcePoolRpnT)(nil),     // 33: TransformService2.C2S_StartResourcePoolRpn_t
+	(*C2S_StopResourcePoolReqT)(nil),      // 34: TransformService2.C2S_StopResourcePoolReq_t
+	(*C2S_StopResourcePoolRpnT)(nil),      // 35: TransformService2.C2S_StopResourcePoolRpn_t
+	(*C2S_AddJobTypeReqT)(nil),            // 36: TransformService2.C2S_AddJobTypeReq_t
+	(*C2S_GetJobTypeReqT)(nil),            // 37: TransformService2.C2S_GetJobTypeReq_t
+	(*C2S_GetJobTypeRpnT)(nil),            // 38: TransformService2.C2S_GetJobTypeRpn_t
+	(*S2C_AddJobTypeRpnT)(nil),            // 39: TransformService2.S2C_AddJobTypeRpn_t
+	(*C2S_RemoveJobTypeReqT)(nil),         // 40: TransformService2.C2S_RemoveJobTypeReq_t
+	(*S2C_RemoveJobTypeRpnT)(nil),         // 41: TransformService2.S2C_RemoveJobTypeRpn_t
+	(*C2S_SetJobTypeReqT)(nil),            // 42: TransformService2.C2S_SetJobTypeReq_t
+	(*S2C_SetJobTypeRpnT)(nil),            // 43: TransformService2.S2C_SetJobTypeRpn_t
+	(*C2S_CreateJobReq)(nil),              // 44: TransformService2.C2S_CreateJobReq
+	(*S2C_CreateJobRpn)(nil),              // 45: TransformService2.S2C_CreateJobRpn
+	(*C2S_CreateJobsReq)(nil),             // 46: TransformService2.C2S_CreateJobsReq
+	(*S2C_CreateJobsRpn)(nil),             // 47: TransformService2.S2C_CreateJobsRpn
+	(*CreateJobResult)(nil),               // 48: TransformService2.CreateJobResult
+	(*C2S_GetJobInfoReq)(nil),             // 49: TransformService2.C2S_GetJobInfoReq
+	(*S2C_GetJobInfoRpn)(nil),             // 50: TransformService2.S2C_GetJobInfoRpn
+	(*C2S_CancelJobReq)(nil),              // 51: TransformService2.C2S_CancelJobReq
+	(*S2C_CancelJobRpn)(nil),              // 52: TransformService2.S2C_CancelJobRpn
+	(*C2S_PauseJobReq)(nil),               // 53: TransformService2.C2S_PauseJobReq
+	(*S2C_PauseJobRpn)(nil),               // 54: TransformService2.S2C_PauseJobRpn
+	(*C2S_ResumeJobReq)(nil),              // 55: TransformService2.C2S_ResumeJobReq
+	(*S2C_ResumeJobRpn)(nil),              // 56: TransformService2.S2C_ResumeJobRpn
+	(*C2S_RetryJobReq)(nil),               // 57: TransformService2.C2S_RetryJobReq
+	(*S2C_RetryJobRpn)(nil),               // 58: TransformService2.S2C_RetryJobRpn
+	(*C2S_GetJobQueueInfoReq)(nil),        // 59: TransformService2.C2S_GetJobQueueInfoReq
+	(*S2C_GetJobQueueInfoRpn)(nil),        // 60: TransformService2.S2C_GetJobQueueInfoRpn
+	(*JobQueueInfo)(nil),                  // 61: TransformService2.JobQueueInfo
+	(*C2S_WatchJobReq)(nil),               // 62: TransformService2.C2S_WatchJobReq
+	(*C2S_WatchJobsReq)(nil),              // 63: TransformService2.C2S_WatchJobsReq
+	(*JobEvent)(nil),                      // 64: TransformService2.JobEvent
+	(*C2S_ListJobsReq)(nil),               // 65: TransformService2.C2S_ListJobsReq
+	(*S2C_ListJobsRpn)(nil),               // 66: TransformService2.S2C_ListJobsRpn
+	(*JobInfo)(nil),                       // 67: TransformService2.JobInfo
+	(*FileProgress)(nil),                  // 68: TransformService2.FileProgress
+	(*Quota)(nil),                         // 69: TransformService2.Quota
+	(*C2S_AddQuotaReq)(nil),               // 70: TransformService2.C2S_AddQuotaReq
+	(*S2C_AddQuotaRpn)(nil),               // 71: TransformService2.S2C_AddQuotaRpn
+	(*C2S_RemoveQuotaReq)(nil),            // 72: TransformService2.C2S_RemoveQuotaReq
+	(*S2C_RemoveQuotaRpn)(nil),            // 73: TransformService2.S2C_RemoveQuotaRpn
+	(*C2S_SetQuotaReq)(nil),               // 74: TransformService2.C2S_SetQuotaReq
+	(*S2C_SetQuotaRpn)(nil),               // 75: TransformService2.S2C_SetQuotaRpn
+	(*C2S_GetQuotaReq)(nil),               // 76: TransformService2.C2S_GetQuotaReq
+	(*S2C_GetQuotaRpn)(nil),               // 77: TransformService2.S2C_GetQuotaRpn
+	(*C2S_QueryQuotaReq)(nil),             // 78: TransformService2.C2S_QueryQuotaReq
+	(*S2C_QueryQuotaRpn)(nil),             // 79: TransformService2.S2C_QueryQuotaRpn
+	(*C2S_GetUsageReq)(nil),               // 80: TransformService2.C2S_GetUsageReq
+	(*S2C_GetUsageRpn)(nil),               // 81: TransformService2.S2C_GetUsageRpn
+	(*S2C_ExportUsageRpn)(nil),            // 82: TransformService2.S2C_ExportUsageRpn
+	(*Usage)(nil),                         // 83: TransformService2.Usage
+	(*C2S_SetWebhookReq)(nil),             // 84: TransformService2.C2S_SetWebhookReq
+	(*S2C_SetWebhookRpn)(nil),             // 85: TransformService2.S2C_SetWebhookRpn
+	(*C2S_RemoveWebhookReq)(nil),          // 86: TransformService2.C2S_RemoveWebhookReq
+	(*S2C_RemoveWebhookRpn)(nil),          // 87: TransformService2.S2C_RemoveWebhookRpn
+	(*C2S_QueryWebhookDeliveriesReq)(nil), // 88: TransformService2.C2S_QueryWebhookDeliveriesReq
+	(*S2C_QueryWebhookDeliveriesRpn)(nil), // 89: TransformService2.S2C_QueryWebhookDeliveriesRpn
+	(*WebhookDelivery)(nil),               // 90: TransformService2.WebhookDelivery
+	nil,                                   // 91: TransformService2.ResourcePool.ResourceLimitsEntry
+}
+var file_TransformService2_proto_depIdxs = []int32{
+	4,  // 0: TransformService2.JobType.RetryPolicy:type_name -> TransformService2.RetryPolicy
+	3,  // 1: TransformService2.JobType.Timeouts:type_name -> TransformService2.Timeouts
+	2,  // 2: TransformService2.C2S_QueryJobTypeRpn_t.JobTypes:type_name -> TransformService2.JobType
+	9,  // 3: TransformService2.C2S_QueryJobSetRpn_t.Jobsets:type_name -> TransformService2.JobSet
+	9,  // 4: TransformService2.C2S_GetJobSetRpn_t.Jobset:type_name -> TransformService2.JobSet
+	24, // 5: TransformService2.C2S_GetResourcePoolRpn_t.Pool:type_name -> TransformService2.ResourcePool
+	24, // 6: TransformService2.C2S_QueryResourcePoolRpn_t.ResourcePools:type_name -> TransformService2.ResourcePool
+	91, // 7: TransformService2.ResourcePool.ResourceLimits:type_name -> TransformService2.ResourcePool.ResourceLimitsEntry
+	25, // 8: TransformService2.C2S_AddResourcePoolReq_t.ResourceLimit:type_name -> TransformService2.ResourceLimitOfTask
+	25, // 9: TransformService2.C2S_SetResourcePoolReq_t.ResourceLimit:type_name -> TransformService2.ResourceLimitOfTask
+	4,  // 10: TransformService2.C2S_AddJobTypeReq_t.RetryPolicy:type_name -> TransformService2.RetryPolicy
+	3,  // 11: TransformService2.C2S_AddJobTypeReq_t.Timeouts:type_name -> TransformService2.Timeouts
+	2,  // 12: TransformService2.C2S_GetJobTypeRpn_t.JobType:type_name -> TransformService2.JobType
+	4,  // 13: TransformService2.C2S_SetJobTypeReq_t.RetryPolicy:type_name -> TransformService2.RetryPolicy
+	3,  // 14: TransformService2.C2S_SetJobTypeReq_t.Timeouts:type_name -> TransformService2.Timeouts
+	44, // 15: TransformService2.C2S_CreateJobsReq.jobs:type_name -> TransformService2.C2S_CreateJobReq
+	48, // 16: TransformService2.S2C_CreateJobsRpn.results:type_name -> TransformService2.CreateJobResult
+	67, // 17: TransformService2.S2C_GetJobInfoRpn.data:type_name -> TransformService2.JobInfo
+	61, // 18: TransformService2.S2C_GetJobQueueInfoRpn.data:type_name -> TransformService2.JobQueueInfo
+	68, // 19: TransformService2.JobEvent.files:type_name -> TransformService2.FileProgress
+	67, // 20: TransformService2.S2C_ListJobsRpn.jobs:type_name -> TransformService2.JobInfo
+	68, // 21: TransformService2.JobInfo.files:type_name -> TransformService2.FileProgress
+	69, // 22: TransformService2.C2S_AddQuotaReq.quota:type_name -> TransformService2.Quota
+	69, // 23: TransformService2.C2S_SetQuotaReq.quota:type_name -> TransformService2.Quota
+	69, // 24: TransformService2.S2C_GetQuotaRpn.quota:type_name -> TransformService2.Quota
+	69, // 25: TransformService2.S2C_QueryQuotaRpn.quotas:type_name -> TransformService2.Quota
+	83, // 26: TransformService2.S2C_GetUsageRpn.usages:type_name -> TransformService2.Usage
+	90, // 27: TransformService2.S2C_QueryWebhookDeliveriesRpn.deliveries:type_name -> TransformService2.WebhookDelivery
+	25, // 28: TransformService2.ResourcePool.ResourceLimitsEntry.value:type_name -> TransformService2.ResourceLimitOfTask
+	44, // 29: TransformService2.TransformV2.CreateJob:input_type -> TransformService2.C2S_CreateJobReq
+	46, // 30: TransformService2.TransformV2.CreateJobs:input_type -> TransformService2.C2S_CreateJobsReq
+	49, // 31: TransformService2.TransformV2.GetJobInfo:input_type -> TransformService2.C2S_GetJobInfoReq
+	51, // 32: TransformService2.TransformV2.CancelJob:input_type -> TransformService2.C2S_CancelJobReq
+	53, // 33: TransformService2.TransformV2.PauseJob:input_type -> TransformService2.C2S_PauseJobReq
+	55, // 34: TransformService2.TransformV2.ResumeJob:input_type -> TransformService2.C2S_ResumeJobReq
+	57, // 35: TransformService2.TransformV2.RetryJob:input_type -> TransformService2.C2S_RetryJobReq
+	59, // 36: TransformService2.TransformV2.GetJobQueueInfo:input_type -> TransformService2.C2S_GetJobQueueInfoReq
+	62, // 37: TransformService2.TransformV2.WatchJob:input_type -> TransformService2.C2S_WatchJobReq
+	63, // 38: TransformService2.TransformV2.WatchJobs:input_type -> TransformService2.C2S_WatchJobsReq
+	65, // 39: TransformService2.TransformV2.ListJobs:input_type -> TransformService2.C2S_ListJobsReq
+	70, // 40: TransformService2.QuotaManagement.AddQuota:input_type -> TransformService2.C2S_AddQuotaReq
+	72, // 41: TransformService2.QuotaManagement.RemoveQuota:input_type -> TransformService2.C2S_RemoveQuotaReq
+	74, // 42: TransformService2.QuotaManagement.SetQuota:input_type -> TransformService2.C2S_SetQuotaReq
+	76, // 43: TransformService2.QuotaManagement.GetQuota:input_type -> TransformService2.C2S_GetQuotaReq
+	78, // 44: TransformService2.QuotaManagement.QueryQuota:input_type -> TransformService2.C2S_QueryQuotaReq
+	0,  // 45: TransformService2.TenantManagement.SetTenantConfig:input_type -> TransformService2.C2S_SetTanentConfigReq_t
+	0,  // 46: TransformService2.TenantManagement.SetDefaultTenantConfig:input_type -> TransformService2.C2S_SetTanentConfigReq_t
+	36, // 47: TransformService2.JobManagement.AddJobType:input_type -> TransformService2.C2S_AddJobTypeReq_t
+	40, // 48: TransformService2.JobManagement.RemoveJobType:input_type -> TransformService2.C2S_RemoveJobTypeReq_t
+	42, // 49: TransformService2.JobManagement.SetJobType:input_type -> TransformService2.C2S_SetJobTypeReq_t
+	37, // 50: TransformService2.JobManagement.GetJobType:input_type -> TransformService2.C2S_GetJobTypeReq_t
+	6,  // 51: TransformService2.JobManagement.QueryJobType:input_type -> TransformService2.C2S_QueryJobTypeReq_t
+	14, // 52: TransformService2.JobManagement.AddJobSet:input_type -> TransformService2.C2S_AddJobSetReq_t
+	16, // 53: TransformService2.JobManagement.RemoveJobSet:input_type -> TransformService2.C2S_RemoveJobSetReq_t
+	15, // 54: TransformService2.JobManagement.SetJobSet:input_type -> TransformService2.C2S_SetJobSetReq_t
+	10, // 55: TransformService2.JobManagement.GetJobSet:input_type -> TransformService2.C2S_GetJobSetReq_t
+	7,  // 56: TransformService2.JobManagement.QueryJobSet:input_type -> TransformService2.C2S_QueryJobSetReq_t
+	12, // 57: TransformService2.JobManagement.SetJobFixedArguments:input_type -> TransformService2.C2S_SetJobFixedArgumentsReq_t
+	26, // 58: TransformService2.ResourcePoolManagement.AddResourcePool:input_type -> TransformService2.C2S_AddResourcePoolReq_t
+	28, // 59: TransformService2.ResourcePoolManagement.RemoveResourcePool:input_type -> TransformService2.C2S_RemoveResourcePoolReq_t
+	30, // 60: TransformService2.ResourcePoolManagement.SetResourcePool:input_type -> TransformService2.C2S_SetResourcePoolReq_t
+	20, // 61: TransformService2.ResourcePoolManagement.GetResourcePool:input_type -> TransformService2.C2S_GetResourcePoolReq_t
+	22, // 62: TransformService2.ResourcePoolManagement.QueryResourcePool:input_type -> TransformService2.C2S_QueryResourcePoolReq_t
+	32, // 63: TransformService2.ResourcePoolManagement.StartResourcePool:input_type -> TransformService2.C2S_StartResourcePoolReq_t
+	34, // 64: TransformService2.ResourcePoolManagement.StopResourcePool:input_type -> TransformService2.C2S_StopResourcePoolReq_t
+	84, // 65: TransformService2.WebhookManagement.SetWebhook:input_type -> TransformService2.C2S_SetWebhookReq
+	86, // 66: TransformService2.WebhookManagement.RemoveWebhook:input_type -> TransformService2.C2S_RemoveWebhookReq
+	88, // 67: TransformService2.WebhookManagement.QueryWebhookDeliveries:input_type -> TransformService2.C2S_QueryWebhookDeliveriesReq
+	80, // 68: TransformService2.UsageMetering.GetUsage:input_type -> TransformService2.C2S_GetUsageReq
+	80, // 69: TransformService2.UsageMetering.ExportUsage:input_type -> TransformService2.C2S_GetUsageReq
+	45, // 70: TransformService2.TransformV2.CreateJob:output_type -> TransformService2.S2C_CreateJobRpn
+	47, // 71: TransformService2.TransformV2.CreateJobs:output_type -> TransformService2.S2C_CreateJobsRpn
+	50, // 72: TransformService2.TransformV2.GetJobInfo:output_type -> TransformService2.S2C_GetJobInfoRpn
+	52, // 73: TransformService2.TransformV2.CancelJob:output_type -> TransformService2.S2C_CancelJobRpn
+	54, // 74: TransformService2.TransformV2.PauseJob:output_type -> TransformService2.S2C_PauseJobRpn
+	56, // 75: TransformService2.TransformV2.ResumeJob:output_type -> TransformService2.S2C_ResumeJobRpn
+	58, // 76: TransformService2.TransformV2.RetryJob:output_type -> TransformService2.S2C_RetryJobRpn
+	60, // 77: TransformService2.TransformV2.GetJobQueueInfo:output_type -> TransformService2.S2C_GetJobQueueInfoRpn
+	64, // 78: TransformService2.TransformV2.WatchJob:output_type -> TransformService2.JobEvent
+	64, // 79: TransformService2.TransformV2.WatchJobs:output_type -> TransformService2.JobEvent
+	66, // 80: TransformService2.TransformV2.ListJobs:output_type -> TransformService2.S2C_ListJobsRpn
+	71, // 81: TransformService2.QuotaManagement.AddQuota:output_type -> TransformService2.S2C_AddQuotaRpn
+	73, // 82: TransformService2.QuotaManagement.RemoveQuota:output_type -> TransformService2.S2C_RemoveQuotaRpn
+	75, // 83: TransformService2.QuotaManagement.SetQuota:output_type -> TransformService2.S2C_SetQuotaRpn
+	77, // 84: TransformService2.QuotaManagement.GetQuota:output_type -> TransformService2.S2C_GetQuotaRpn
+	79, // 85: TransformService2.QuotaManagement.QueryQuota:output_type -> TransformService2.S2C_QueryQuotaRpn
+	1,  // 86: TransformService2.TenantManagement.SetTenantConfig:output_type -> TransformService2.C2S_SetTanentConfigRpn_t
+	1,  // 87: TransformService2.TenantManagement.SetDefaultTenantConfig:output_type -> TransformService2.C2S_SetTanentConfigRpn_t
+	39, // 88: TransformService2.JobManagement.AddJobType:output_type -> TransformService2.S2C_AddJobTypeRpn_t
+	41, // 89: TransformService2.JobManagement.RemoveJobType:output_type -> TransformService2.S2C_RemoveJobTypeRpn_t
+	43, // 90: TransformService2.JobManagement.SetJobType:output_type -> TransformService2.S2C_SetJobTypeRpn_t
+	38, // 91: TransformService2.JobManagement.GetJobType:output_type -> TransformService2.C2S_GetJobTypeRpn_t
+	5,  // 92: TransformService2.JobManagement.QueryJobType:output_type -> TransformService2.C2S_QueryJobTypeRpn_t
+	19, // 93: TransformService2.JobManagement.AddJobSet:output_type -> TransformService2.C2S_AddJobSetRpn_t
+	17, // 94: TransformService2.JobManagement.RemoveJobSet:output_type -> TransformService2.C2S_RemoveJobSetRpn_t
+	18, // 95: TransformService2.JobManagement.SetJobSet:output_type -> TransformService2.C2S_SetJobSetRpn_t
+	11, // 96: TransformService2.JobManagement.GetJobSet:output_type -> TransformService2.C2S_GetJobSetRpn_t
+	8,  // 97: TransformService2.JobManagement.QueryJobSet:output_type -> TransformService2.C2S_QueryJobSetRpn_t
+	13, // 98: TransformService2.JobManagement.SetJobFixedArguments:output_type -> TransformService2.C2S_SetJobFixedArgumentsRpn_t
+	27, // 99: TransformService2.ResourcePoolManagement.AddResourcePool:output_type -> TransformService2.C2S_AddResourcePoolRpn_t
+	29, // 100: TransformService2.ResourcePoolManagement.RemoveResourcePool:output_type -> TransformService2.C2S_RemoveResourcePoolRpn_t
+	31, // 101: TransformService2.ResourcePoolManagement.SetResourcePool:output_type -> TransformService2.C2S_SetResourcePoolRpn_t
+	21, // 102: TransformService2.ResourcePoolManagement.GetResourcePool:output_type -> TransformService2.C2S_GetResourcePoolRpn_t
+	23, // 103: TransformService2.ResourcePoolManagement.QueryResourcePool:output_type -> TransformService2.C2S_QueryResourcePoolRpn_t
+	33, // 104: TransformService2.ResourcePoolManagement.StartResourcePool:output_type -> TransformService2.C2S_StartResourcePoolRpn_t
+	35, // 105: TransformService2.ResourcePoolManagement.StopResourcePool:output_type -> TransformService2.C2S_StopResourcePoolRpn_t
+	85, // 106: TransformService2.WebhookManagement.SetWebhook:output_type -> TransformService2.S2C_SetWebhookRpn
+	87, // 107: TransformService2.WebhookManagement.RemoveWebhook:output_type -> TransformService2.S2C_RemoveWebhookRpn
+	89, // 108: TransformService2.WebhookManagement.QueryWebhookDeliveries:output_type -> TransformService2.S2C_QueryWebhookDeliveriesRpn
+	81, // 109: TransformService2.UsageMetering.GetUsage:output_type -> TransformService2.S2C_GetUsageRpn
+	82, // 110: TransformService2.UsageMetering.ExportUsage:output_type -> TransformService2.S2C_ExportUsageRpn
+	70, // [70:111] is the sub-list for method output_type
+	29, // [29:70] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
+}
+
+func init() { file_TransformService2_proto_init() }
+func file_TransformService2_proto_init() {
+	if File_TransformService2_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_TransformService2_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetTanentConfigReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetTanentConfigRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobType); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Timeouts); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetryPolicy); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_QueryJobTypeRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_QueryJobTypeReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_QueryJobSetReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_QueryJobSetRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetJobSetReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetJobSetRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetJobFixedArgumentsReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetJobFixedArgumentsRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_AddJobSetReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetJobSetReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_RemoveJobSetReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_RemoveJobSetRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetJobSetRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_AddJobSetRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetResourcePoolReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetResourcePoolRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_QueryResourcePoolReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_QueryResourcePoolRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourcePool); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResourceLimitOfTask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_AddResourcePoolReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_AddResourcePoolRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_RemoveResourcePoolReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_RemoveResourcePoolRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetResourcePoolReqT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetResourcePoolRpnT); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_StartResourcePoolReqT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3873,8 +8295,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetTanentConfigRpnT); i {
+		file_TransformService2_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_StartResourcePoolRpnT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3885,8 +8307,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobType); i {
+		file_TransformService2_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_StopResourcePoolReqT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3897,8 +8319,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_QueryJobTypeRpnT); i {
+		file_TransformService2_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_StopResourcePoolRpnT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3909,8 +8331,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_QueryJobTypeReqT); i {
+		file_TransformService2_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_AddJobTypeReqT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3921,8 +8343,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_QueryJobSetReqT); i {
+		file_TransformService2_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetJobTypeReqT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3933,8 +8355,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_QueryJobSetRpnT); i {
+		file_TransformService2_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetJobTypeRpnT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3945,8 +8367,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobSet); i {
+		file_TransformService2_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_AddJobTypeRpnT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3957,8 +8379,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_GetJobSetReqT); i {
+		file_TransformService2_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_RemoveJobTypeReqT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3969,8 +8391,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_GetJobSetRpnT); i {
+		file_TransformService2_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_RemoveJobTypeRpnT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3981,8 +8403,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetJobFixedArgumentsReqT); i {
+		file_TransformService2_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetJobTypeReqT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3993,8 +8415,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetJobFixedArgumentsRpnT); i {
+		file_TransformService2_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_SetJobTypeRpnT); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4005,8 +8427,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_AddJobSetReqT); i {
+		file_TransformService2_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_CreateJobReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4017,8 +8439,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetJobSetReqT); i {
+		file_TransformService2_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_CreateJobRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4029,8 +8451,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_RemoveJobSetReqT); i {
+		file_TransformService2_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_CreateJobsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4041,8 +8463,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_RemoveJobSetRpnT); i {
+		file_TransformService2_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_CreateJobsRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4053,8 +8475,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetJobSetRpnT); i {
+		file_TransformService2_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateJobResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4065,8 +8487,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_AddJobSetRpnT); i {
+		file_TransformService2_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetJobInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4077,8 +8499,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_GetResourcePoolReqT); i {
+		file_TransformService2_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_GetJobInfoRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4089,8 +8511,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_GetResourcePoolRpnT); i {
+		file_TransformService2_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_CancelJobReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4101,8 +8523,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_QueryResourcePoolReqT); i {
+		file_TransformService2_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_CancelJobRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4113,8 +8535,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_QueryResourcePoolRpnT); i {
+		file_TransformService2_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_PauseJobReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4125,8 +8547,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourcePool); i {
+		file_TransformService2_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_PauseJobRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4137,8 +8559,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ResourceLimitOfTask); i {
+		file_TransformService2_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_ResumeJobReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4149,8 +8571,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_AddResourcePoolReqT); i {
+		file_TransformService2_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_ResumeJobRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4161,8 +8583,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_AddResourcePoolRpnT); i {
+		file_TransformService2_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_RetryJobReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4173,8 +8595,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_RemoveResourcePoolReqT); i {
+		file_TransformService2_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_RetryJobRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4185,8 +8607,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_RemoveResourcePoolRpnT); i {
+		file_TransformService2_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetJobQueueInfoReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4197,8 +8619,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetResourcePoolReqT); i {
+		file_TransformService2_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_GetJobQueueInfoRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4209,8 +8631,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetResourcePoolRpnT); i {
+		file_TransformService2_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobQueueInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4221,8 +8643,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_AddJobTypeReqT); i {
+		file_TransformService2_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_WatchJobReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4233,8 +8655,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_GetJobTypeReqT); i {
+		file_TransformService2_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_WatchJobsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4245,8 +8667,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_GetJobTypeRpnT); i {
+		file_TransformService2_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4257,8 +8679,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S2C_AddJobTypeRpnT); i {
+		file_TransformService2_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_ListJobsReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4269,8 +8691,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_RemoveJobTypeReqT); i {
+		file_TransformService2_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_ListJobsRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4281,8 +8703,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S2C_RemoveJobTypeRpnT); i {
+		file_TransformService2_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JobInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4293,8 +8715,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_SetJobTypeReqT); i {
+		file_TransformService2_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileProgress); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4305,8 +8727,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S2C_SetJobTypeRpnT); i {
+		file_TransformService2_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Quota); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4317,8 +8739,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_CreateJobReq); i {
+		file_TransformService2_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_AddQuotaReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4329,8 +8751,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S2C_CreateJobRpn); i {
+		file_TransformService2_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_AddQuotaRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4341,8 +8763,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_GetJobInfoReq); i {
+		file_TransformService2_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_RemoveQuotaReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4353,8 +8775,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S2C_GetJobInfoRpn); i {
+		file_TransformService2_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_RemoveQuotaRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4365,8 +8787,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*C2S_CancelJobReq); i {
+		file_TransformService2_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetQuotaReq); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4377,8 +8799,8 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*S2C_CancelJobRpn); i {
+		file_TransformService2_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_SetQuotaRpn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4389,8 +8811,176 @@ func file_TransformService2_proto_init() {
 				return nil
 			}
 		}
-		file_TransformService2_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JobInfo); i {
+		file_TransformService2_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetQuotaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_GetQuotaRpn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_QueryQuotaReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_QueryQuotaRpn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_GetUsageReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_GetUsageRpn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_ExportUsageRpn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Usage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_SetWebhookReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_SetWebhookRpn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_RemoveWebhookReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_RemoveWebhookRpn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*C2S_QueryWebhookDeliveriesReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*S2C_QueryWebhookDeliveriesRpn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_TransformService2_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookDelivery); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4402,18 +8992,18 @@ func file_TransformService2_proto_init() {
 			}
 		}
 	}
-	file_TransformService2_proto_msgTypes[18].OneofWrappers = []interface{}{}
-	file_TransformService2_proto_msgTypes[19].OneofWrappers = []interface{}{}
+	file_TransformService2_proto_msgTypes[20].OneofWrappers = []interface{}{}
 	file_TransformService2_proto_msgTypes[21].OneofWrappers = []interface{}{}
+	file_TransformService2_proto_msgTypes[23].OneofWrappers = []interface{}{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_TransformService2_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   46,
+			NumMessages:   92,
 			NumExtensions: 0,
-			NumServices:   4,
+			NumServices:   7,
 		},
 		GoTypes:           file_TransformService2_proto_goTypes,
 		DependencyIndexes: file_TransformService2_proto_depIdxs,
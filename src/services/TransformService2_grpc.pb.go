@@ -23,8 +23,16 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type TransformV2Client interface {
 	CreateJob(ctx context.Context, in *C2S_CreateJobReq, opts ...grpc.CallOption) (*S2C_CreateJobRpn, error)
+	CreateJobs(ctx context.Context, in *C2S_CreateJobsReq, opts ...grpc.CallOption) (*S2C_CreateJobsRpn, error)
 	GetJobInfo(ctx context.Context, in *C2S_GetJobInfoReq, opts ...grpc.CallOption) (*S2C_GetJobInfoRpn, error)
 	CancelJob(ctx context.Context, in *C2S_CancelJobReq, opts ...grpc.CallOption) (*S2C_CancelJobRpn, error)
+	PauseJob(ctx context.Context, in *C2S_PauseJobReq, opts ...grpc.CallOption) (*S2C_PauseJobRpn, error)
+	ResumeJob(ctx context.Context, in *C2S_ResumeJobReq, opts ...grpc.CallOption) (*S2C_ResumeJobRpn, error)
+	RetryJob(ctx context.Context, in *C2S_RetryJobReq, opts ...grpc.CallOption) (*S2C_RetryJobRpn, error)
+	GetJobQueueInfo(ctx context.Context, in *C2S_GetJobQueueInfoReq, opts ...grpc.CallOption) (*S2C_GetJobQueueInfoRpn, error)
+	WatchJob(ctx context.Context, in *C2S_WatchJobReq, opts ...grpc.CallOption) (TransformV2_WatchJobClient, error)
+	WatchJobs(ctx context.Context, in *C2S_WatchJobsReq, opts ...grpc.CallOption) (TransformV2_WatchJobsClient, error)
+	ListJobs(ctx context.Context, in *C2S_ListJobsReq, opts ...grpc.CallOption) (*S2C_ListJobsRpn, error)
 }
 
 type transformV2Client struct {
@@ -44,6 +52,15 @@ func (c *transformV2Client) CreateJob(ctx context.Context, in *C2S_CreateJobReq,
 	return out, nil
 }
 
+func (c *transformV2Client) CreateJobs(ctx context.Context, in *C2S_CreateJobsReq, opts ...grpc.CallOption) (*S2C_CreateJobsRpn, error) {
+	out := new(S2C_CreateJobsRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.TransformV2/CreateJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *transformV2Client) GetJobInfo(ctx context.Context, in *C2S_GetJobInfoReq, opts ...grpc.CallOption) (*S2C_GetJobInfoRpn, error) {
 	out := new(S2C_GetJobInfoRpn)
 	err := c.cc.Invoke(ctx, "/TransformService2.TransformV2/GetJobInfo", in, out, opts...)
@@ -62,13 +79,130 @@ func (c *transformV2Client) CancelJob(ctx context.Context, in *C2S_CancelJobReq,
 	return out, nil
 }
 
+func (c *transformV2Client) PauseJob(ctx context.Context, in *C2S_PauseJobReq, opts ...grpc.CallOption) (*S2C_PauseJobRpn, error) {
+	out := new(S2C_PauseJobRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.TransformV2/PauseJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transformV2Client) ResumeJob(ctx context.Context, in *C2S_ResumeJobReq, opts ...grpc.CallOption) (*S2C_ResumeJobRpn, error) {
+	out := new(S2C_ResumeJobRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.TransformV2/ResumeJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transformV2Client) RetryJob(ctx context.Context, in *C2S_RetryJobReq, opts ...grpc.CallOption) (*S2C_RetryJobRpn, error) {
+	out := new(S2C_RetryJobRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.TransformV2/RetryJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transformV2Client) GetJobQueueInfo(ctx context.Context, in *C2S_GetJobQueueInfoReq, opts ...grpc.CallOption) (*S2C_GetJobQueueInfoRpn, error) {
+	out := new(S2C_GetJobQueueInfoRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.TransformV2/GetJobQueueInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transformV2Client) WatchJob(ctx context.Context, in *C2S_WatchJobReq, opts ...grpc.CallOption) (TransformV2_WatchJobClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TransformV2_ServiceDesc.Streams[0], "/TransformService2.TransformV2/WatchJob", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transformV2WatchJobClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransformV2_WatchJobClient interface {
+	Recv() (*JobEvent, error)
+	grpc.ClientStream
+}
+
+type transformV2WatchJobClient struct {
+	grpc.ClientStream
+}
+
+func (x *transformV2WatchJobClient) Recv() (*JobEvent, error) {
+	m := new(JobEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *transformV2Client) WatchJobs(ctx context.Context, in *C2S_WatchJobsReq, opts ...grpc.CallOption) (TransformV2_WatchJobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TransformV2_ServiceDesc.Streams[1], "/TransformService2.TransformV2/WatchJobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &transformV2WatchJobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type TransformV2_WatchJobsClient interface {
+	Recv() (*JobEvent, error)
+	grpc.ClientStream
+}
+
+type transformV2WatchJobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *transformV2WatchJobsClient) Recv() (*JobEvent, error) {
+	m := new(JobEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *transformV2Client) ListJobs(ctx context.Context, in *C2S_ListJobsReq, opts ...grpc.CallOption) (*S2C_ListJobsRpn, error) {
+	out := new(S2C_ListJobsRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.TransformV2/ListJobs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TransformV2Server is the server API for TransformV2 service.
 // All implementations must embed UnimplementedTransformV2Server
 // for forward compatibility
 type TransformV2Server interface {
 	CreateJob(context.Context, *C2S_CreateJobReq) (*S2C_CreateJobRpn, error)
+	CreateJobs(context.Context, *C2S_CreateJobsReq) (*S2C_CreateJobsRpn, error)
 	GetJobInfo(context.Context, *C2S_GetJobInfoReq) (*S2C_GetJobInfoRpn, error)
 	CancelJob(context.Context, *C2S_CancelJobReq) (*S2C_CancelJobRpn, error)
+	PauseJob(context.Context, *C2S_PauseJobReq) (*S2C_PauseJobRpn, error)
+	ResumeJob(context.Context, *C2S_ResumeJobReq) (*S2C_ResumeJobRpn, error)
+	RetryJob(context.Context, *C2S_RetryJobReq) (*S2C_RetryJobRpn, error)
+	GetJobQueueInfo(context.Context, *C2S_GetJobQueueInfoReq) (*S2C_GetJobQueueInfoRpn, error)
+	WatchJob(*C2S_WatchJobReq, TransformV2_WatchJobServer) error
+	WatchJobs(*C2S_WatchJobsReq, TransformV2_WatchJobsServer) error
+	ListJobs(context.Context, *C2S_ListJobsReq) (*S2C_ListJobsRpn, error)
 	mustEmbedUnimplementedTransformV2Server()
 }
 
@@ -79,12 +213,36 @@ type UnimplementedTransformV2Server struct {
 func (UnimplementedTransformV2Server) CreateJob(context.Context, *C2S_CreateJobReq) (*S2C_CreateJobRpn, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateJob not implemented")
 }
+func (UnimplementedTransformV2Server) CreateJobs(context.Context, *C2S_CreateJobsReq) (*S2C_CreateJobsRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateJobs not implemented")
+}
 func (UnimplementedTransformV2Server) GetJobInfo(context.Context, *C2S_GetJobInfoReq) (*S2C_GetJobInfoRpn, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobInfo not implemented")
 }
 func (UnimplementedTransformV2Server) CancelJob(context.Context, *C2S_CancelJobReq) (*S2C_CancelJobRpn, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CancelJob not implemented")
 }
+func (UnimplementedTransformV2Server) PauseJob(context.Context, *C2S_PauseJobReq) (*S2C_PauseJobRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseJob not implemented")
+}
+func (UnimplementedTransformV2Server) ResumeJob(context.Context, *C2S_ResumeJobReq) (*S2C_ResumeJobRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeJob not implemented")
+}
+func (UnimplementedTransformV2Server) RetryJob(context.Context, *C2S_RetryJobReq) (*S2C_RetryJobRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetryJob not implemented")
+}
+func (UnimplementedTransformV2Server) GetJobQueueInfo(context.Context, *C2S_GetJobQueueInfoReq) (*S2C_GetJobQueueInfoRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetJobQueueInfo not implemented")
+}
+func (UnimplementedTransformV2Server) WatchJob(*C2S_WatchJobReq, TransformV2_WatchJobServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJob not implemented")
+}
+func (UnimplementedTransformV2Server) WatchJobs(*C2S_WatchJobsReq, TransformV2_WatchJobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchJobs not implemented")
+}
+func (UnimplementedTransformV2Server) ListJobs(context.Context, *C2S_ListJobsReq) (*S2C_ListJobsRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJobs not implemented")
+}
 func (UnimplementedTransformV2Server) mustEmbedUnimplementedTransformV2Server() {}
 
 // UnsafeTransformV2Server may be embedded to opt out of forward compatibility for this service.
@@ -94,82 +252,497 @@ type UnsafeTransformV2Server interface {
 	mustEmbedUnimplementedTransformV2Server()
 }
 
-func RegisterTransformV2Server(s grpc.ServiceRegistrar, srv TransformV2Server) {
-	s.RegisterService(&TransformV2_ServiceDesc, srv)
+func RegisterTransformV2Server(s grpc.ServiceRegistrar, srv TransformV2Server) {
+	s.RegisterService(&TransformV2_ServiceDesc, srv)
+}
+
+func _TransformV2_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_CreateJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).CreateJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/CreateJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).CreateJob(ctx, req.(*C2S_CreateJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransformV2_CreateJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_CreateJobsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).CreateJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/CreateJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).CreateJobs(ctx, req.(*C2S_CreateJobsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransformV2_GetJobInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_GetJobInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).GetJobInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/GetJobInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).GetJobInfo(ctx, req.(*C2S_GetJobInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransformV2_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_CancelJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).CancelJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/CancelJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).CancelJob(ctx, req.(*C2S_CancelJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransformV2_PauseJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_PauseJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).PauseJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/PauseJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).PauseJob(ctx, req.(*C2S_PauseJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransformV2_ResumeJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_ResumeJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).ResumeJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/ResumeJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).ResumeJob(ctx, req.(*C2S_ResumeJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransformV2_RetryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_RetryJobReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).RetryJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/RetryJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).RetryJob(ctx, req.(*C2S_RetryJobReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransformV2_GetJobQueueInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_GetJobQueueInfoReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).GetJobQueueInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/GetJobQueueInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).GetJobQueueInfo(ctx, req.(*C2S_GetJobQueueInfoReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransformV2_WatchJob_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(C2S_WatchJobReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransformV2Server).WatchJob(m, &transformV2WatchJobServer{stream})
+}
+
+type TransformV2_WatchJobServer interface {
+	Send(*JobEvent) error
+	grpc.ServerStream
+}
+
+type transformV2WatchJobServer struct {
+	grpc.ServerStream
+}
+
+func (x *transformV2WatchJobServer) Send(m *JobEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TransformV2_WatchJobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(C2S_WatchJobsReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TransformV2Server).WatchJobs(m, &transformV2WatchJobsServer{stream})
+}
+
+type TransformV2_WatchJobsServer interface {
+	Send(*JobEvent) error
+	grpc.ServerStream
+}
+
+type transformV2WatchJobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *transformV2WatchJobsServer) Send(m *JobEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TransformV2_ListJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_ListJobsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransformV2Server).ListJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.TransformV2/ListJobs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransformV2Server).ListJobs(ctx, req.(*C2S_ListJobsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransformV2_ServiceDesc is the grpc.ServiceDesc for TransformV2 service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransformV2_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TransformService2.TransformV2",
+	HandlerType: (*TransformV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateJob",
+			Handler:    _TransformV2_CreateJob_Handler,
+		},
+		{
+			MethodName: "CreateJobs",
+			Handler:    _TransformV2_CreateJobs_Handler,
+		},
+		{
+			MethodName: "GetJobInfo",
+			Handler:    _TransformV2_GetJobInfo_Handler,
+		},
+		{
+			MethodName: "CancelJob",
+			Handler:    _TransformV2_CancelJob_Handler,
+		},
+		{
+			MethodName: "PauseJob",
+			Handler:    _TransformV2_PauseJob_Handler,
+		},
+		{
+			MethodName: "ResumeJob",
+			Handler:    _TransformV2_ResumeJob_Handler,
+		},
+		{
+			MethodName: "RetryJob",
+			Handler:    _TransformV2_RetryJob_Handler,
+		},
+		{
+			MethodName: "GetJobQueueInfo",
+			Handler:    _TransformV2_GetJobQueueInfo_Handler,
+		},
+		{
+			MethodName: "ListJobs",
+			Handler:    _TransformV2_ListJobs_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchJob",
+			Handler:       _TransformV2_WatchJob_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchJobs",
+			Handler:       _TransformV2_WatchJobs_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "TransformService2.proto",
+}
+
+// QuotaManagementClient is the client API for QuotaManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuotaManagementClient interface {
+	AddQuota(ctx context.Context, in *C2S_AddQuotaReq, opts ...grpc.CallOption) (*S2C_AddQuotaRpn, error)
+	RemoveQuota(ctx context.Context, in *C2S_RemoveQuotaReq, opts ...grpc.CallOption) (*S2C_RemoveQuotaRpn, error)
+	SetQuota(ctx context.Context, in *C2S_SetQuotaReq, opts ...grpc.CallOption) (*S2C_SetQuotaRpn, error)
+	GetQuota(ctx context.Context, in *C2S_GetQuotaReq, opts ...grpc.CallOption) (*S2C_GetQuotaRpn, error)
+	QueryQuota(ctx context.Context, in *C2S_QueryQuotaReq, opts ...grpc.CallOption) (*S2C_QueryQuotaRpn, error)
+}
+
+type quotaManagementClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuotaManagementClient(cc grpc.ClientConnInterface) QuotaManagementClient {
+	return &quotaManagementClient{cc}
+}
+
+func (c *quotaManagementClient) AddQuota(ctx context.Context, in *C2S_AddQuotaReq, opts ...grpc.CallOption) (*S2C_AddQuotaRpn, error) {
+	out := new(S2C_AddQuotaRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.QuotaManagement/AddQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaManagementClient) RemoveQuota(ctx context.Context, in *C2S_RemoveQuotaReq, opts ...grpc.CallOption) (*S2C_RemoveQuotaRpn, error) {
+	out := new(S2C_RemoveQuotaRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.QuotaManagement/RemoveQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaManagementClient) SetQuota(ctx context.Context, in *C2S_SetQuotaReq, opts ...grpc.CallOption) (*S2C_SetQuotaRpn, error) {
+	out := new(S2C_SetQuotaRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.QuotaManagement/SetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaManagementClient) GetQuota(ctx context.Context, in *C2S_GetQuotaReq, opts ...grpc.CallOption) (*S2C_GetQuotaRpn, error) {
+	out := new(S2C_GetQuotaRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.QuotaManagement/GetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaManagementClient) QueryQuota(ctx context.Context, in *C2S_QueryQuotaReq, opts ...grpc.CallOption) (*S2C_QueryQuotaRpn, error) {
+	out := new(S2C_QueryQuotaRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.QuotaManagement/QueryQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuotaManagementServer is the server API for QuotaManagement service.
+// All implementations must embed UnimplementedQuotaManagementServer
+// for forward compatibility
+type QuotaManagementServer interface {
+	AddQuota(context.Context, *C2S_AddQuotaReq) (*S2C_AddQuotaRpn, error)
+	RemoveQuota(context.Context, *C2S_RemoveQuotaReq) (*S2C_RemoveQuotaRpn, error)
+	SetQuota(context.Context, *C2S_SetQuotaReq) (*S2C_SetQuotaRpn, error)
+	GetQuota(context.Context, *C2S_GetQuotaReq) (*S2C_GetQuotaRpn, error)
+	QueryQuota(context.Context, *C2S_QueryQuotaReq) (*S2C_QueryQuotaRpn, error)
+	mustEmbedUnimplementedQuotaManagementServer()
+}
+
+// UnimplementedQuotaManagementServer must be embedded to have forward compatible implementations.
+type UnimplementedQuotaManagementServer struct {
+}
+
+func (UnimplementedQuotaManagementServer) AddQuota(context.Context, *C2S_AddQuotaReq) (*S2C_AddQuotaRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddQuota not implemented")
+}
+func (UnimplementedQuotaManagementServer) RemoveQuota(context.Context, *C2S_RemoveQuotaReq) (*S2C_RemoveQuotaRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveQuota not implemented")
+}
+func (UnimplementedQuotaManagementServer) SetQuota(context.Context, *C2S_SetQuotaReq) (*S2C_SetQuotaRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (UnimplementedQuotaManagementServer) GetQuota(context.Context, *C2S_GetQuotaReq) (*S2C_GetQuotaRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedQuotaManagementServer) QueryQuota(context.Context, *C2S_QueryQuotaReq) (*S2C_QueryQuotaRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryQuota not implemented")
+}
+func (UnimplementedQuotaManagementServer) mustEmbedUnimplementedQuotaManagementServer() {}
+
+// UnsafeQuotaManagementServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuotaManagementServer will
+// result in compilation errors.
+type UnsafeQuotaManagementServer interface {
+	mustEmbedUnimplementedQuotaManagementServer()
+}
+
+func RegisterQuotaManagementServer(s grpc.ServiceRegistrar, srv QuotaManagementServer) {
+	s.RegisterService(&QuotaManagement_ServiceDesc, srv)
+}
+
+func _QuotaManagement_AddQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_AddQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaManagementServer).AddQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.QuotaManagement/AddQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaManagementServer).AddQuota(ctx, req.(*C2S_AddQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QuotaManagement_RemoveQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_RemoveQuotaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaManagementServer).RemoveQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.QuotaManagement/RemoveQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaManagementServer).RemoveQuota(ctx, req.(*C2S_RemoveQuotaReq))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _TransformV2_CreateJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(C2S_CreateJobReq)
+func _QuotaManagement_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_SetQuotaReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransformV2Server).CreateJob(ctx, in)
+		return srv.(QuotaManagementServer).SetQuota(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/TransformService2.TransformV2/CreateJob",
+		FullMethod: "/TransformService2.QuotaManagement/SetQuota",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransformV2Server).CreateJob(ctx, req.(*C2S_CreateJobReq))
+		return srv.(QuotaManagementServer).SetQuota(ctx, req.(*C2S_SetQuotaReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransformV2_GetJobInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(C2S_GetJobInfoReq)
+func _QuotaManagement_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_GetQuotaReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransformV2Server).GetJobInfo(ctx, in)
+		return srv.(QuotaManagementServer).GetQuota(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/TransformService2.TransformV2/GetJobInfo",
+		FullMethod: "/TransformService2.QuotaManagement/GetQuota",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransformV2Server).GetJobInfo(ctx, req.(*C2S_GetJobInfoReq))
+		return srv.(QuotaManagementServer).GetQuota(ctx, req.(*C2S_GetQuotaReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _TransformV2_CancelJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(C2S_CancelJobReq)
+func _QuotaManagement_QueryQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_QueryQuotaReq)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(TransformV2Server).CancelJob(ctx, in)
+		return srv.(QuotaManagementServer).QueryQuota(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/TransformService2.TransformV2/CancelJob",
+		FullMethod: "/TransformService2.QuotaManagement/QueryQuota",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TransformV2Server).CancelJob(ctx, req.(*C2S_CancelJobReq))
+		return srv.(QuotaManagementServer).QueryQuota(ctx, req.(*C2S_QueryQuotaReq))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// TransformV2_ServiceDesc is the grpc.ServiceDesc for TransformV2 service.
+// QuotaManagement_ServiceDesc is the grpc.ServiceDesc for QuotaManagement service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
-var TransformV2_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "TransformService2.TransformV2",
-	HandlerType: (*TransformV2Server)(nil),
+var QuotaManagement_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TransformService2.QuotaManagement",
+	HandlerType: (*QuotaManagementServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "CreateJob",
-			Handler:    _TransformV2_CreateJob_Handler,
+			MethodName: "AddQuota",
+			Handler:    _QuotaManagement_AddQuota_Handler,
 		},
 		{
-			MethodName: "GetJobInfo",
-			Handler:    _TransformV2_GetJobInfo_Handler,
+			MethodName: "RemoveQuota",
+			Handler:    _QuotaManagement_RemoveQuota_Handler,
 		},
 		{
-			MethodName: "CancelJob",
-			Handler:    _TransformV2_CancelJob_Handler,
+			MethodName: "SetQuota",
+			Handler:    _QuotaManagement_SetQuota_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _QuotaManagement_GetQuota_Handler,
+		},
+		{
+			MethodName: "QueryQuota",
+			Handler:    _QuotaManagement_QueryQuota_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -755,6 +1328,8 @@ type ResourcePoolManagementClient interface {
 	SetResourcePool(ctx context.Context, in *C2S_SetResourcePoolReqT, opts ...grpc.CallOption) (*C2S_SetResourcePoolRpnT, error)
 	GetResourcePool(ctx context.Context, in *C2S_GetResourcePoolReqT, opts ...grpc.CallOption) (*C2S_GetResourcePoolRpnT, error)
 	QueryResourcePool(ctx context.Context, in *C2S_QueryResourcePoolReqT, opts ...grpc.CallOption) (*C2S_QueryResourcePoolRpnT, error)
+	StartResourcePool(ctx context.Context, in *C2S_StartResourcePoolReqT, opts ...grpc.CallOption) (*C2S_StartResourcePoolRpnT, error)
+	StopResourcePool(ctx context.Context, in *C2S_StopResourcePoolReqT, opts ...grpc.CallOption) (*C2S_StopResourcePoolRpnT, error)
 }
 
 type resourcePoolManagementClient struct {
@@ -810,6 +1385,24 @@ func (c *resourcePoolManagementClient) QueryResourcePool(ctx context.Context, in
 	return out, nil
 }
 
+func (c *resourcePoolManagementClient) StartResourcePool(ctx context.Context, in *C2S_StartResourcePoolReqT, opts ...grpc.CallOption) (*C2S_StartResourcePoolRpnT, error) {
+	out := new(C2S_StartResourcePoolRpnT)
+	err := c.cc.Invoke(ctx, "/TransformService2.ResourcePoolManagement/StartResourcePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourcePoolManagementClient) StopResourcePool(ctx context.Context, in *C2S_StopResourcePoolReqT, opts ...grpc.CallOption) (*C2S_StopResourcePoolRpnT, error) {
+	out := new(C2S_StopResourcePoolRpnT)
+	err := c.cc.Invoke(ctx, "/TransformService2.ResourcePoolManagement/StopResourcePool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourcePoolManagementServer is the server API for ResourcePoolManagement service.
 // All implementations must embed UnimplementedResourcePoolManagementServer
 // for forward compatibility
@@ -819,6 +1412,8 @@ type ResourcePoolManagementServer interface {
 	SetResourcePool(context.Context, *C2S_SetResourcePoolReqT) (*C2S_SetResourcePoolRpnT, error)
 	GetResourcePool(context.Context, *C2S_GetResourcePoolReqT) (*C2S_GetResourcePoolRpnT, error)
 	QueryResourcePool(context.Context, *C2S_QueryResourcePoolReqT) (*C2S_QueryResourcePoolRpnT, error)
+	StartResourcePool(context.Context, *C2S_StartResourcePoolReqT) (*C2S_StartResourcePoolRpnT, error)
+	StopResourcePool(context.Context, *C2S_StopResourcePoolReqT) (*C2S_StopResourcePoolRpnT, error)
 	mustEmbedUnimplementedResourcePoolManagementServer()
 }
 
@@ -841,6 +1436,12 @@ func (UnimplementedResourcePoolManagementServer) GetResourcePool(context.Context
 func (UnimplementedResourcePoolManagementServer) QueryResourcePool(context.Context, *C2S_QueryResourcePoolReqT) (*C2S_QueryResourcePoolRpnT, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryResourcePool not implemented")
 }
+func (UnimplementedResourcePoolManagementServer) StartResourcePool(context.Context, *C2S_StartResourcePoolReqT) (*C2S_StartResourcePoolRpnT, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartResourcePool not implemented")
+}
+func (UnimplementedResourcePoolManagementServer) StopResourcePool(context.Context, *C2S_StopResourcePoolReqT) (*C2S_StopResourcePoolRpnT, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopResourcePool not implemented")
+}
 func (UnimplementedResourcePoolManagementServer) mustEmbedUnimplementedResourcePoolManagementServer() {
 }
 
@@ -945,6 +1546,42 @@ func _ResourcePoolManagement_QueryResourcePool_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ResourcePoolManagement_StartResourcePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_StartResourcePoolReqT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcePoolManagementServer).StartResourcePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.ResourcePoolManagement/StartResourcePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcePoolManagementServer).StartResourcePool(ctx, req.(*C2S_StartResourcePoolReqT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourcePoolManagement_StopResourcePool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_StopResourcePoolReqT)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourcePoolManagementServer).StopResourcePool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.ResourcePoolManagement/StopResourcePool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourcePoolManagementServer).StopResourcePool(ctx, req.(*C2S_StopResourcePoolReqT))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ResourcePoolManagement_ServiceDesc is the grpc.ServiceDesc for ResourcePoolManagement service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -972,6 +1609,294 @@ var ResourcePoolManagement_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryResourcePool",
 			Handler:    _ResourcePoolManagement_QueryResourcePool_Handler,
 		},
+		{
+			MethodName: "StartResourcePool",
+			Handler:    _ResourcePoolManagement_StartResourcePool_Handler,
+		},
+		{
+			MethodName: "StopResourcePool",
+			Handler:    _ResourcePoolManagement_StopResourcePool_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "TransformService2.proto",
+}
+
+// WebhookManagementClient is the client API for WebhookManagement service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WebhookManagementClient interface {
+	SetWebhook(ctx context.Context, in *C2S_SetWebhookReq, opts ...grpc.CallOption) (*S2C_SetWebhookRpn, error)
+	RemoveWebhook(ctx context.Context, in *C2S_RemoveWebhookReq, opts ...grpc.CallOption) (*S2C_RemoveWebhookRpn, error)
+	QueryWebhookDeliveries(ctx context.Context, in *C2S_QueryWebhookDeliveriesReq, opts ...grpc.CallOption) (*S2C_QueryWebhookDeliveriesRpn, error)
+}
+
+type webhookManagementClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWebhookManagementClient(cc grpc.ClientConnInterface) WebhookManagementClient {
+	return &webhookManagementClient{cc}
+}
+
+func (c *webhookManagementClient) SetWebhook(ctx context.Context, in *C2S_SetWebhookReq, opts ...grpc.CallOption) (*S2C_SetWebhookRpn, error) {
+	out := new(S2C_SetWebhookRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.WebhookManagement/SetWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookManagementClient) RemoveWebhook(ctx context.Context, in *C2S_RemoveWebhookReq, opts ...grpc.CallOption) (*S2C_RemoveWebhookRpn, error) {
+	out := new(S2C_RemoveWebhookRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.WebhookManagement/RemoveWebhook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookManagementClient) QueryWebhookDeliveries(ctx context.Context, in *C2S_QueryWebhookDeliveriesReq, opts ...grpc.CallOption) (*S2C_QueryWebhookDeliveriesRpn, error) {
+	out := new(S2C_QueryWebhookDeliveriesRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.WebhookManagement/QueryWebhookDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WebhookManagementServer is the server API for WebhookManagement service.
+// All implementations must embed UnimplementedWebhookManagementServer
+// for forward compatibility
+type WebhookManagementServer interface {
+	SetWebhook(context.Context, *C2S_SetWebhookReq) (*S2C_SetWebhookRpn, error)
+	RemoveWebhook(context.Context, *C2S_RemoveWebhookReq) (*S2C_RemoveWebhookRpn, error)
+	QueryWebhookDeliveries(context.Context, *C2S_QueryWebhookDeliveriesReq) (*S2C_QueryWebhookDeliveriesRpn, error)
+	mustEmbedUnimplementedWebhookManagementServer()
+}
+
+// UnimplementedWebhookManagementServer must be embedded to have forward compatible implementations.
+type UnimplementedWebhookManagementServer struct {
+}
+
+func (UnimplementedWebhookManagementServer) SetWebhook(context.Context, *C2S_SetWebhookReq) (*S2C_SetWebhookRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetWebhook not implemented")
+}
+func (UnimplementedWebhookManagementServer) RemoveWebhook(context.Context, *C2S_RemoveWebhookReq) (*S2C_RemoveWebhookRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebhook not implemented")
+}
+func (UnimplementedWebhookManagementServer) QueryWebhookDeliveries(context.Context, *C2S_QueryWebhookDeliveriesReq) (*S2C_QueryWebhookDeliveriesRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryWebhookDeliveries not implemented")
+}
+func (UnimplementedWebhookManagementServer) mustEmbedUnimplementedWebhookManagementServer() {}
+
+// UnsafeWebhookManagementServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WebhookManagementServer will
+// result in compilation errors.
+type UnsafeWebhookManagementServer interface {
+	mustEmbedUnimplementedWebhookManagementServer()
+}
+
+func RegisterWebhookManagementServer(s grpc.ServiceRegistrar, srv WebhookManagementServer) {
+	s.RegisterService(&WebhookManagement_ServiceDesc, srv)
+}
+
+func _WebhookManagement_SetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_SetWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookManagementServer).SetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.WebhookManagement/SetWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookManagementServer).SetWebhook(ctx, req.(*C2S_SetWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookManagement_RemoveWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_RemoveWebhookReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookManagementServer).RemoveWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.WebhookManagement/RemoveWebhook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookManagementServer).RemoveWebhook(ctx, req.(*C2S_RemoveWebhookReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookManagement_QueryWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_QueryWebhookDeliveriesReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookManagementServer).QueryWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.WebhookManagement/QueryWebhookDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookManagementServer).QueryWebhookDeliveries(ctx, req.(*C2S_QueryWebhookDeliveriesReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookManagement_ServiceDesc is the grpc.ServiceDesc for WebhookManagement service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookManagement_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TransformService2.WebhookManagement",
+	HandlerType: (*WebhookManagementServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetWebhook",
+			Handler:    _WebhookManagement_SetWebhook_Handler,
+		},
+		{
+			MethodName: "RemoveWebhook",
+			Handler:    _WebhookManagement_RemoveWebhook_Handler,
+		},
+		{
+			MethodName: "QueryWebhookDeliveries",
+			Handler:    _WebhookManagement_QueryWebhookDeliveries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "TransformService2.proto",
+}
+
+// UsageMeteringClient is the client API for UsageMetering service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type UsageMeteringClient interface {
+	GetUsage(ctx context.Context, in *C2S_GetUsageReq, opts ...grpc.CallOption) (*S2C_GetUsageRpn, error)
+	ExportUsage(ctx context.Context, in *C2S_GetUsageReq, opts ...grpc.CallOption) (*S2C_ExportUsageRpn, error)
+}
+
+type usageMeteringClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUsageMeteringClient(cc grpc.ClientConnInterface) UsageMeteringClient {
+	return &usageMeteringClient{cc}
+}
+
+func (c *usageMeteringClient) GetUsage(ctx context.Context, in *C2S_GetUsageReq, opts ...grpc.CallOption) (*S2C_GetUsageRpn, error) {
+	out := new(S2C_GetUsageRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.UsageMetering/GetUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *usageMeteringClient) ExportUsage(ctx context.Context, in *C2S_GetUsageReq, opts ...grpc.CallOption) (*S2C_ExportUsageRpn, error) {
+	out := new(S2C_ExportUsageRpn)
+	err := c.cc.Invoke(ctx, "/TransformService2.UsageMetering/ExportUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UsageMeteringServer is the server API for UsageMetering service.
+// All implementations must embed UnimplementedUsageMeteringServer
+// for forward compatibility
+type UsageMeteringServer interface {
+	GetUsage(context.Context, *C2S_GetUsageReq) (*S2C_GetUsageRpn, error)
+	ExportUsage(context.Context, *C2S_GetUsageReq) (*S2C_ExportUsageRpn, error)
+	mustEmbedUnimplementedUsageMeteringServer()
+}
+
+// UnimplementedUsageMeteringServer must be embedded to have forward compatible implementations.
+type UnimplementedUsageMeteringServer struct {
+}
+
+func (UnimplementedUsageMeteringServer) GetUsage(context.Context, *C2S_GetUsageReq) (*S2C_GetUsageRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsage not implemented")
+}
+func (UnimplementedUsageMeteringServer) ExportUsage(context.Context, *C2S_GetUsageReq) (*S2C_ExportUsageRpn, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportUsage not implemented")
+}
+func (UnimplementedUsageMeteringServer) mustEmbedUnimplementedUsageMeteringServer() {}
+
+// UnsafeUsageMeteringServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UsageMeteringServer will
+// result in compilation errors.
+type UnsafeUsageMeteringServer interface {
+	mustEmbedUnimplementedUsageMeteringServer()
+}
+
+func RegisterUsageMeteringServer(s grpc.ServiceRegistrar, srv UsageMeteringServer) {
+	s.RegisterService(&UsageMetering_ServiceDesc, srv)
+}
+
+func _UsageMetering_GetUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_GetUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsageMeteringServer).GetUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.UsageMetering/GetUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsageMeteringServer).GetUsage(ctx, req.(*C2S_GetUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UsageMetering_ExportUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(C2S_GetUsageReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UsageMeteringServer).ExportUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/TransformService2.UsageMetering/ExportUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UsageMeteringServer).ExportUsage(ctx, req.(*C2S_GetUsageReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UsageMetering_ServiceDesc is the grpc.ServiceDesc for UsageMetering service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UsageMetering_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "TransformService2.UsageMetering",
+	HandlerType: (*UsageMeteringServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetUsage",
+			Handler:    _UsageMetering_GetUsage_Handler,
+		},
+		{
+			MethodName: "ExportUsage",
+			Handler:    _UsageMetering_ExportUsage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "TransformService2.proto",
@@ -0,0 +1,107 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.19.1
+// source: ZixelBus.proto
+
+package services
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// ProducerMsgServerClient is the client API for ProducerMsgServer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type ProducerMsgServerClient interface {
+	// 发送消息
+	ProducerMsg(ctx context.Context, in *MsgRequest, opts ...grpc.CallOption) (*MsgResponse, error)
+}
+
+type producerMsgServerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewProducerMsgServerClient(cc grpc.ClientConnInterface) ProducerMsgServerClient {
+	return &producerMsgServerClient{cc}
+}
+
+func (c *producerMsgServerClient) ProducerMsg(ctx context.Context, in *MsgRequest, opts ...grpc.CallOption) (*MsgResponse, error) {
+	out := new(MsgResponse)
+	err := c.cc.Invoke(ctx, "/ProducerMsgServer/ProducerMsg", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ProducerMsgServerServer is the server API for ProducerMsgServer service.
+// All implementations must embed UnimplementedProducerMsgServerServer
+// for forward compatibility
+type ProducerMsgServerServer interface {
+	// 发送消息
+	ProducerMsg(context.Context, *MsgRequest) (*MsgResponse, error)
+	mustEmbedUnimplementedProducerMsgServerServer()
+}
+
+// UnimplementedProducerMsgServerServer must be embedded to have forward compatible implementations.
+type UnimplementedProducerMsgServerServer struct {
+}
+
+func (UnimplementedProducerMsgServerServer) ProducerMsg(context.Context, *MsgRequest) (*MsgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProducerMsg not implemented")
+}
+func (UnimplementedProducerMsgServerServer) mustEmbedUnimplementedProducerMsgServerServer() {}
+
+// UnsafeProducerMsgServerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ProducerMsgServerServer will
+// result in compilation errors.
+type UnsafeProducerMsgServerServer interface {
+	mustEmbedUnimplementedProducerMsgServerServer()
+}
+
+func RegisterProducerMsgServerServer(s grpc.ServiceRegistrar, srv ProducerMsgServerServer) {
+	s.RegisterService(&ProducerMsgServer_ServiceDesc, srv)
+}
+
+func _ProducerMsgServer_ProducerMsg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProducerMsgServerServer).ProducerMsg(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ProducerMsgServer/ProducerMsg",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProducerMsgServerServer).ProducerMsg(ctx, req.(*MsgRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ProducerMsgServer_ServiceDesc is the grpc.ServiceDesc for ProducerMsgServer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ProducerMsgServer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "ProducerMsgServer",
+	HandlerType: (*ProducerMsgServerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ProducerMsg",
+			Handler:    _ProducerMsgServer_ProducerMsg_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ZixelBus.proto",
+}
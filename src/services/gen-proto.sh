@@ -1,7 +1,7 @@
 #!/bin/bash
 
 pwd
-files="UserService.proto AccountService.proto PrivilegeService.proto StorageService2.proto TransformService2.proto"
+files="UserService.proto AccountService.proto PrivilegeService.proto StorageService2.proto TransformService2.proto ZixelBus.proto"
     
 for file in $files; do
     echo $file
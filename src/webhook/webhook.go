@@ -0,0 +1,154 @@
+// Package webhook posts signed JSON payloads to the callback URLs of integrators.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Headers of a delivery
+const (
+	SignatureHeader = "X-Transform-Signature" // sha256=<hex HMAC-SHA256 of "<timestamp>.<body>">
+	TimestampHeader = "X-Transform-Timestamp" // Unix time in seconds when the payload was signed
+	DeliveryHeader  = "X-Transform-Delivery"  // Identifier of the delivery, the same on every attempt
+)
+
+// Sign returns the signature of the body sent at the timestamp.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify reports whether the signature matches the body sent at the timestamp,
+// receivers should also reject timestamps too far in the past.
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// NewSecret returns a random secret for signing payloads.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ValidateURL returns an error unless the URL is an absolute http or https URL
+// whose host only resolves to public addresses, see Blocked.
+func ValidateURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("webhook URL %q must be an absolute http or https URL", rawURL)
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, u.Hostname())
+	if err != nil {
+		return fmt.Errorf("webhook URL %q cannot be resolved: %v", rawURL, err)
+	}
+	for _, addr := range addrs {
+		if Blocked(addr.IP) {
+			return fmt.Errorf("webhook URL %q resolves to the non-public address %s", rawURL, addr.IP)
+		}
+	}
+	return nil
+}
+
+// Blocked reports whether payloads must not be sent to the address, so webhooks cannot reach
+// the services next to the server: loopback, private, link-local and unspecified addresses.
+func Blocked(ip net.IP) bool {
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified()
+}
+
+// NewClient returns a client for Post that refuses to connect to blocked addresses. The address
+// is checked when the connection is made, so a host resolving to another address after
+// ValidateURL is still refused. Proxies are not used, they would connect in place of the client.
+func NewClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		Control: func(network string, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || Blocked(ip) {
+				return fmt.Errorf("webhook address %s is not public", host)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
+
+// ErrNoSecret is returned by Post without a secret, receivers could not verify the payload.
+var ErrNoSecret = errors.New("webhook secret is required")
+
+// Post sends the body to the URL signed with the secret. It returns the HTTP status code,
+// 0 if no response was received, and an error unless the receiver answered with a 2xx status.
+func Post(ctx context.Context, client *http.Client, rawURL string, deliveryId string, secret string, body []byte) (int, error) {
+	if secret == "" {
+		return 0, ErrNoSecret
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, rawURL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := time.Now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(DeliveryHeader, deliveryId)
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	// Drain a little of the body so the connection can be reused
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// Backoff returns the delay before the next attempt after the given number of failed attempts,
+// it doubles with every attempt starting at initial and is capped at max.
+func Backoff(attempts int, initial time.Duration, max time.Duration) time.Duration {
+	delay := initial
+	for i := 1; i < attempts && delay < max; i++ {
+		delay *= 2
+	}
+	if delay > max {
+		delay = max
+	}
+	return delay
+}
@@ -0,0 +1,179 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPostSignsPayload(t *testing.T) {
+	const secret = "s3cret"
+	body := []byte(`{"jobId":"42","status":"Succeeded"}`)
+
+	var received []byte
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received, _ = io.ReadAll(r.Body)
+		header = r.Header.Clone()
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	code, err := Post(context.Background(), server.Client(), server.URL, "d-1", secret, body)
+	if err != nil {
+		t.Fatalf("Post: %v", err)
+	}
+	if code != http.StatusNoContent {
+		t.Errorf("status code = %d, want %d", code, http.StatusNoContent)
+	}
+	if string(received) != string(body) {
+		t.Errorf("body = %s, want %s", received, body)
+	}
+	if got := header.Get("Content-Type"); got != "application/json" {
+		t.Errorf("Content-Type = %q", got)
+	}
+	if got := header.Get(DeliveryHeader); got != "d-1" {
+		t.Errorf("%s = %q, want d-1", DeliveryHeader, got)
+	}
+
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		t.Fatalf("invalid %s: %v", TimestampHeader, err)
+	}
+	if !Verify(secret, timestamp, received, header.Get(SignatureHeader)) {
+		t.Errorf("signature %q does not verify", header.Get(SignatureHeader))
+	}
+	if Verify("other", timestamp, received, header.Get(SignatureHeader)) {
+		t.Error("signature verifies with the wrong secret")
+	}
+	if Verify(secret, timestamp+1, received, header.Get(SignatureHeader)) {
+		t.Error("signature verifies with the wrong timestamp")
+	}
+}
+
+func TestPostWithoutSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unsigned payload was sent")
+	}))
+	defer server.Close()
+
+	if _, err := Post(context.Background(), server.Client(), server.URL, "d-2", "", []byte(`{}`)); err != ErrNoSecret {
+		t.Fatalf("Post without secret = %v, want %v", err, ErrNoSecret)
+	}
+}
+
+func TestPostFailures(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "unavailable", http.StatusServiceUnavailable)
+	}))
+
+	code, err := Post(context.Background(), server.Client(), server.URL, "d-3", "s", []byte(`{}`))
+	if err == nil {
+		t.Error("expected an error for a 503 response")
+	}
+	if code != http.StatusServiceUnavailable {
+		t.Errorf("status code = %d, want %d", code, http.StatusServiceUnavailable)
+	}
+
+	// A receiver that is gone reports no status code
+	server.Close()
+	code, err = Post(context.Background(), server.Client(), server.URL, "d-3", "s", []byte(`{}`))
+	if err == nil || code != 0 {
+		t.Errorf("Post to closed server = %d, %v; want 0 and an error", code, err)
+	}
+}
+
+func TestPostTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := server.Client()
+	client.Timeout = 50 * time.Millisecond
+	if _, err := Post(context.Background(), client, server.URL, "d-4", "s", []byte(`{}`)); err == nil {
+		t.Error("expected a timeout error")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, 5 * time.Second},
+		{1, 5 * time.Second},
+		{2, 10 * time.Second},
+		{3, 20 * time.Second},
+		{6, 160 * time.Second},
+		{7, 5 * time.Minute},
+		{100, 5 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts, 5*time.Second, 5*time.Minute); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestValidateURL(t *testing.T) {
+	ctx := context.Background()
+	for _, u := range []string{"http://93.184.215.14/hook", "https://[2606:4700::1111]:8443/a?b=c"} {
+		if err := ValidateURL(ctx, u); err != nil {
+			t.Errorf("ValidateURL(%q): %v", u, err)
+		}
+	}
+	for _, u := range []string{
+		"", "example.com/hook", "ftp://example.com", "/relative", "http://",
+		"http://127.0.0.1/hook", "http://localhost:8080/hook", "http://10.1.2.3/", "http://192.168.0.1/",
+		"http://169.254.169.254/latest/meta-data", "http://0.0.0.0/", "http://[::1]/", "http://[fd00::1]/",
+	} {
+		if err := ValidateURL(ctx, u); err == nil {
+			t.Errorf("ValidateURL(%q) succeeded, want an error", u)
+		}
+	}
+}
+
+func TestBlocked(t *testing.T) {
+	tests := []struct {
+		ip      string
+		blocked bool
+	}{
+		{"93.184.215.14", false},
+		{"2606:4700::1111", false},
+		{"127.0.0.1", true},
+		{"10.0.0.1", true},
+		{"172.16.5.4", true},
+		{"192.168.1.1", true},
+		{"169.254.169.254", true},
+		{"0.0.0.0", true},
+		{"::1", true},
+		{"::", true},
+		{"fe80::1", true},
+		{"fc00::1", true},
+	}
+	for _, tt := range tests {
+		if got := Blocked(net.ParseIP(tt.ip)); got != tt.blocked {
+			t.Errorf("Blocked(%s) = %v, want %v", tt.ip, got, tt.blocked)
+		}
+	}
+}
+
+func TestNewClientRefusesBlockedAddresses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("payload was sent to a loopback address")
+	}))
+	defer server.Close()
+
+	code, err := Post(context.Background(), NewClient(time.Second), server.URL, "d-5", "s", []byte(`{}`))
+	if err == nil || code != 0 {
+		t.Errorf("Post to loopback = %d, %v; want 0 and an error", code, err)
+	}
+}
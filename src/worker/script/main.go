@@ -0,0 +1,13 @@
+package main
+
+import (
+	"transform2/worker/script/scriptworker"
+
+	"gitlab.zixel.cn/go/framework/logger"
+)
+
+var log = logger.Get()
+
+func main() {
+	scriptworker.Start()
+}
@@ -0,0 +1,190 @@
+package scriptworker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+	"transform2/models"
+	"transform2/scscript"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+)
+
+// shell runs the entry commands of the job types.
+const shell = "/bin/sh"
+
+// exitCategories maps exit codes of entry commands to job error categories, the codes follow
+// sysexits.h and timeout(1). Other non-zero codes are reported as ConversionFailed.
+var exitCategories = map[int]string{
+	64:  models.ErrorInvalidRequest,        // EX_USAGE
+	65:  models.ErrorUnsupportedFormat,     // EX_DATAERR
+	66:  models.ErrorInvalidRequest,        // EX_NOINPUT
+	69:  models.ErrorInsufficientResources, // EX_UNAVAILABLE
+	124: models.ErrorTimeout,               // timeout(1)
+}
+
+// nonRetryableCategories are not retried, running the command again cannot succeed.
+var nonRetryableCategories = map[string]bool{
+	models.ErrorInvalidRequest:    true,
+	models.ErrorUnsupportedFormat: true,
+}
+
+// RunScriptRequest is the command an attempt of RunScript runs.
+type RunScriptRequest struct {
+	JeScript        string   // Entry command, run by the shell
+	ScScript        string   // Progress script applied to every line the command writes
+	Parameters      string   // Decoded job parameters, passed in JOB_PARAMETERS
+	StorageToken    string   // Download and upload token, passed in JOB_STORAGE_TOKEN
+	FixedParameters []string // Fixed parameters of the job type, passed as positional parameters $1, $2, ...
+}
+
+type RunScriptResult struct {
+	ExitCode int
+	Progress scscript.Progress // Progress collected from the output when the command exited
+	Duration time.Duration     // Time the command ran
+}
+
+// RunScript runs the entry command in a new working directory and heartbeats the progress collected
+// from its stdout and stderr as FileProgressUpdate of the file named after the job. The command and
+// its children are killed once the job is cancelled.
+func RunScript(ctx context.Context, req RunScriptRequest) (*RunScriptResult, error) {
+	info := activity.GetInfo(ctx)
+	log.Infof("RunScript %s attempt %d", info.WorkflowExecution.ID, info.Attempt)
+	start := time.Now()
+
+	parser, err := scscript.Compile(req.ScScript)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError("invalid progress script: "+err.Error(), models.ErrorInvalidRequest, err)
+	}
+
+	dir, err := os.MkdirTemp("", "jescript-")
+	if err != nil {
+		return nil, temporal.NewApplicationErrorWithCause(err.Error(), models.ErrorInsufficientResources, err)
+	}
+	defer os.RemoveAll(dir)
+
+	// $0 names the command in the messages of the shell
+	args := append([]string{"-c", req.JeScript, "jescript"}, req.FixedParameters...)
+	cmd := exec.Command(shell, args...)
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(),
+		"JOB_ID="+info.WorkflowExecution.ID,
+		"JOB_PARAMETERS="+req.Parameters,
+		"JOB_STORAGE_TOKEN="+req.StorageToken,
+	)
+	setProcessGroup(cmd)
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	stderr, err := cmd.StderrPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, temporal.NewNonRetryableApplicationError("cannot start the entry command: "+err.Error(), models.ErrorInvalidRequest, err)
+	}
+
+	// both streams go through the progress script, the last stderr line explains a failure.
+	// The output cannot be followed once a stream fails, the command is killed then.
+	var mu sync.Mutex
+	var progress scscript.Progress
+	var lastError string
+	var readErr error
+	var wg sync.WaitGroup
+	scan := func(r io.Reader, isStderr bool) {
+		defer wg.Done()
+		err := scscript.ReadLines(r, func(line string) {
+			mu.Lock()
+			defer mu.Unlock()
+			parser.Parse(line, &progress)
+			if isStderr && line != "" {
+				lastError = line
+			}
+		})
+		if err != nil {
+			mu.Lock()
+			defer mu.Unlock()
+			if readErr == nil {
+				readErr = err
+				killProcessGroup(cmd)
+			}
+		}
+	}
+	wg.Add(2)
+	go scan(stdout, false)
+	go scan(stderr, true)
+
+	// the pipes are read to the end before waiting for the command
+	done := make(chan error, 1)
+	go func() {
+		wg.Wait()
+		done <- cmd.Wait()
+	}()
+
+	// heartbeat while the command runs, the context is cancelled
+	// by a heartbeat once the job is cancelled.
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			killProcessGroup(cmd)
+			<-done
+			log.Infof("RunScript %s stopped: %v", info.WorkflowExecution.ID, ctx.Err())
+			return nil, ctx.Err()
+		case err := <-done:
+			mu.Lock()
+			defer mu.Unlock()
+			if readErr != nil {
+				return nil, temporal.NewApplicationErrorWithCause("cannot read the output of the entry command: "+readErr.Error(), models.ErrorInternal, readErr)
+			}
+			return runScriptResult(err, progress, lastError, time.Since(start))
+		case <-ticker.C:
+			mu.Lock()
+			details := models.FileProgressUpdate{File: info.WorkflowExecution.ID, Progress: progress.Percent, Message: progress.Message}
+			mu.Unlock()
+			activity.RecordHeartbeat(ctx, details)
+		}
+	}
+}
+
+// runScriptResult maps the exit status of the command to the result of the activity. The error
+// reported by the progress script is preferred over the last stderr line as message of a failure.
+func runScriptResult(err error, progress scscript.Progress, lastError string, duration time.Duration) (*RunScriptResult, error) {
+	if err == nil {
+		progress.Percent = 100
+		return &RunScriptResult{Progress: progress, Duration: duration}, nil
+	}
+
+	var exitErr *exec.ExitError
+	if !errors.As(err, &exitErr) {
+		return nil, temporal.NewApplicationErrorWithCause(err.Error(), models.ErrorInternal, err)
+	}
+
+	code := exitErr.ExitCode()
+	category, ok := exitCategories[code]
+	if !ok {
+		category = models.ErrorConversionFailed
+	}
+	message := fmt.Sprintf("entry command exited with %d", code)
+	if progress.Error != "" {
+		message += ": " + progress.Error
+	} else if lastError != "" {
+		message += ": " + lastError
+	}
+
+	log.Infof("RunScript failed: %s", message)
+	if nonRetryableCategories[category] {
+		return nil, temporal.NewNonRetryableApplicationError(message, category, err)
+	}
+	return nil, temporal.NewApplicationErrorWithCause(message, category, err)
+}
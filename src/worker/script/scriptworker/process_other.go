@@ -0,0 +1,13 @@
+//go:build !unix
+
+package scriptworker
+
+import "os/exec"
+
+// setProcessGroup does nothing, children of the command are not killed with it on this platform.
+func setProcessGroup(cmd *exec.Cmd) {}
+
+// killProcessGroup kills the started command.
+func killProcessGroup(cmd *exec.Cmd) {
+	cmd.Process.Kill()
+}
@@ -0,0 +1,67 @@
+package scriptworker
+
+import (
+	"transform2/dispatch"
+	"transform2/models"
+
+	"gitlab.zixel.cn/go/framework/config"
+	"gitlab.zixel.cn/go/framework/logger"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/worker"
+)
+
+var log = logger.Get()
+
+func Start() error {
+
+	log.Info("scriptworker start ...")
+	// Create a Temporal Client to communicate with the Temporal Cluster.
+	// A Temporal Client is a heavyweight object that should be created just once per process.
+	c, err := client.Dial(client.Options{
+		HostPort: config.GetString("temporal.address", "localhost:7233"),
+	})
+
+	if err != nil {
+		log.Fatalln("Unable to create Temporal Client", err)
+		return err
+	}
+	defer c.Close()
+
+	// Every job type run by this worker is registered with the ScriptWorkflow and this task queue,
+	// VIP jobs go to the priority queue. The priority queue is polled by more pollers, so its tasks are picked up first.
+	taskQueue := config.GetString("script.task_queue", "script-queue")
+	vipTaskQueue := dispatch.TaskQueue(taskQueue, models.PriorityVIP, config.GetString("priority.vip_queue_suffix", "-vip"))
+
+	w := worker.New(c, taskQueue, worker.Options{
+		MaxConcurrentWorkflowTaskPollers:   int(config.GetInt("script.pollers", 2)),
+		MaxConcurrentActivityTaskPollers:   int(config.GetInt("script.pollers", 2)),
+		MaxConcurrentActivityExecutionSize: int(config.GetInt("script.parallel_commands", 4)),
+	})
+	vip := worker.New(c, vipTaskQueue, worker.Options{
+		MaxConcurrentWorkflowTaskPollers:   int(config.GetInt("script.vip_pollers", 8)),
+		MaxConcurrentActivityTaskPollers:   int(config.GetInt("script.vip_pollers", 8)),
+		MaxConcurrentActivityExecutionSize: int(config.GetInt("script.parallel_commands", 4)),
+	})
+
+	// This worker hosts both Workflow and Activity functions.
+	for _, w := range []worker.Worker{vip, w} {
+		w.RegisterWorkflow(ScriptWorkflow)
+		w.RegisterActivity(RunScript)
+	}
+
+	// Start listening to the priority Task Queue, the other one runs until interrupted.
+	if err = vip.Start(); err != nil {
+		log.Fatalln("unable to start VIP Worker", err)
+		return err
+	}
+	defer vip.Stop()
+
+	ch := worker.InterruptCh()
+	err = w.Run(ch)
+	if err != nil {
+		log.Fatalln("unable to start Worker", err)
+		return err
+	}
+
+	return nil
+}
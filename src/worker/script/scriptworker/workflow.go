@@ -0,0 +1,123 @@
+package scriptworker
+
+import (
+	"encoding/base64"
+	"time"
+	"transform2/models"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// pauseSignalsChange versions the handling of the pause and resume signals, workflows started
+// before it was added replay without it.
+const pauseSignalsChange = "pause-signals"
+
+// ScriptWorkflow runs the entry command of the job type with the parameters of the job. The job is
+// reported as a single file named after the job, its result is derived from the exit code of the command.
+// The JobPauseSignal holds back the command until the JobResumeSignal arrives, a running command finishes.
+func ScriptWorkflow(ctx workflow.Context, token string, parameters string, fixedParameters []string, options models.WorkflowOptions) ([]models.FileProgress, error) {
+	// Apply the options, a command is alive as long as it heartbeats.
+	// The job type may declare other timeouts scaled by the input size.
+	activityOptions := workflow.ActivityOptions{
+		ScheduleToStartTimeout: time.Minute,
+		StartToCloseTimeout:    time.Hour * 24,
+		HeartbeatTimeout:       time.Second * 10,
+		WaitForCancellation:    true,
+		RetryPolicy:            options.RetryPolicy.TemporalPolicy(),
+	}
+	options.Timeouts.ApplyTo(&activityOptions, options.InputSize)
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+
+	// report the progress of the job to GetJobInfo
+	progress := models.JobProgress{Status: models.JobStatusRunning}
+	if err := workflow.SetQueryHandler(ctx, models.JobProgressQuery, func() (models.JobProgress, error) {
+		return progress, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if options.JeScript == "" {
+		return nil, temporal.NewNonRetryableApplicationError("job type has no entry command", models.ErrorInvalidRequest, nil)
+	}
+
+	dec, err := base64.StdEncoding.DecodeString(parameters)
+	if err != nil {
+		return nil, temporal.NewNonRetryableApplicationError("parameters are not base64 encoded", models.ErrorInvalidRequest, err)
+	}
+
+	progress.Files = []models.FileProgress{{File: workflow.GetInfo(ctx).WorkflowExecution.ID, Status: models.FileStatusPending}}
+	file := &progress.Files[0]
+
+	// pause and resume signals are handled until the command returns, a paused job does not start it
+	if workflow.GetVersion(ctx, pauseSignalsChange, workflow.DefaultVersion, 1) == 1 {
+		paused := false
+		workflow.Go(ctx, func(ctx workflow.Context) {
+			pauseCh := workflow.GetSignalChannel(ctx, models.JobPauseSignal)
+			resumeCh := workflow.GetSignalChannel(ctx, models.JobResumeSignal)
+			for {
+				selector := workflow.NewSelector(ctx)
+				selector.AddReceive(pauseCh, func(c workflow.ReceiveChannel, more bool) {
+					c.Receive(ctx, nil)
+					log.Infof("Job paused.")
+					paused = true
+					progress.Status = models.JobStatusPaused
+				})
+				selector.AddReceive(resumeCh, func(c workflow.ReceiveChannel, more bool) {
+					c.Receive(ctx, nil)
+					log.Infof("Job resumed.")
+					paused = false
+					progress.Status = models.JobStatusRunning
+				})
+				selector.Select(ctx)
+			}
+		})
+
+		// the job was cancelled while it was paused
+		if err := workflow.Await(ctx, func() bool { return !paused }); err != nil {
+			file.Status = models.FileStatusCancelled
+			file.ErrorCategory = models.ErrorCancelled
+			progress.Status = models.JobStatusCancelled
+			return nil, err
+		}
+	}
+
+	var res RunScriptResult
+	scheduled := workflow.Now(ctx)
+	err = workflow.ExecuteActivity(ctx, RunScript, RunScriptRequest{
+		JeScript:        options.JeScript,
+		ScScript:        options.ScScript,
+		Parameters:      string(dec),
+		StorageToken:    token,
+		FixedParameters: fixedParameters,
+	}).Get(ctx, &res)
+	// failed commands count the time until the failure was reported, including retries
+	file.DurationMs = workflow.Now(ctx).Sub(scheduled).Milliseconds()
+
+	// the job was cancelled, the command has stopped at this point
+	if ctx.Err() != nil {
+		file.Status = models.FileStatusCancelled
+		file.ErrorCategory = models.ErrorCancelled
+		progress.Status = models.JobStatusCancelled
+		return nil, ctx.Err()
+	}
+
+	if err != nil {
+		log.Error("RunScript failed.", err)
+		file.Status = models.FileStatusFailed
+		file.Message = err.Error()
+		file.ErrorCategory = models.ActivityErrorCategory(err)
+		progress.ErrorCategory = file.ErrorCategory
+	} else {
+		log.Infof("RunScript %s success.", file.File)
+		file.Status = models.FileStatusSucceeded
+		file.Progress = 100
+		file.Message = res.Progress.Message
+		file.DurationMs = res.Duration.Milliseconds()
+	}
+	progress.Progress = 100
+
+	// a failed command does not fail the workflow, the job status is derived from the file result
+	progress.Status = models.JobResultStatus(progress.Files)
+	return progress.Files, nil
+}
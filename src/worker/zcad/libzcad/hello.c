@@ -3,6 +3,11 @@
 extern "C" {
 #endif
 
+int LoadStep(char *file, int step, int steps) {
+    printf("Process file %s, step %d of %d!\n", file, step + 1, steps);
+    return 0;
+}
+
 void Hello(char *file) {
     printf("Process file %s!\n", file);
 }
@@ -10,4 +15,3 @@ void Hello(char *file) {
 #ifdef __cplusplus
 }
 #endif
-
@@ -5,13 +5,47 @@ package libzcad
 // #include "hello.h"
 import "C"
 import (
-	"time"
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
 	"unsafe"
 )
 
-func Hello(file string) {
-	time.Sleep(3 * time.Second)
+// Steps is the number of steps ZCAD loads a file in, the progress is reported after every step.
+const Steps = 20
+
+// Checkpoint is the state of a partly loaded file, loading the file again continues after it.
+type Checkpoint struct {
+	Step int // Number of steps completed
+}
+
+// Percent returns the progress of the file at the checkpoint.
+func (c Checkpoint) Percent() int32 {
+	return int32(c.Step * 100 / Steps)
+}
+
+// Output returns the file ZCAD writes the loaded model of the file to.
+func Output(file string) string {
+	return strings.TrimSuffix(file, filepath.Ext(file)) + ".zcad"
+}
+
+// Load processes the file from the checkpoint on and calls progress after every completed step.
+// It returns the context error without calling into the native library once the context is done,
+// the last checkpoint passed to progress resumes the file.
+func Load(ctx context.Context, file string, from Checkpoint, progress func(Checkpoint)) error {
 	p := C.CString(file)
 	defer C.free(unsafe.Pointer(p))
-	C.Hello(p)
+
+	for step := from.Step; step < Steps; step++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if code := C.LoadStep(p, C.int(step), C.int(Steps)); code != 0 {
+			return fmt.Errorf("loading step %d of %s failed with code %d", step+1, file, int(code))
+		}
+		progress(Checkpoint{Step: step + 1})
+	}
+	return nil
 }
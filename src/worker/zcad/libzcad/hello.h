@@ -4,6 +4,12 @@
 extern "C" {
 #endif
 
+// LoadStep processes the step of the file, steps are numbered from 0 to steps - 1.
+// It returns 0 on success and an error code otherwise.
+// The Go package calls LoadStep, run build.sh to rebuild a library built before LoadStep was added.
+int LoadStep(char *file, int step, int steps);
+
+// Hello processes the whole file, it is kept for programs linked against earlier versions of the library.
 void Hello(char *file);
 
 #ifdef __cplusplus
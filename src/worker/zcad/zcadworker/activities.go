@@ -2,16 +2,95 @@ package zcadworker
 
 import (
 	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+	"transform2/models"
 	"transform2/worker/zcad/libzcad"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
 )
 
+// supportedFormats are the file extensions ZCAD can load.
+var supportedFormats = map[string]bool{
+	".dwg": true,
+	".dxf": true,
+}
+
 type ZCAD_LoadFileResult struct {
-	File   string
-	Status string
+	File     string
+	Status   string
+	Outputs  []string      // Files written for the loaded file
+	Duration time.Duration // Time spent loading the file
+}
+
+// ZCAD_LoadFileProgress is the heartbeat detail of ZCAD_LoadFile, a retried attempt resumes from its checkpoint.
+// The server reads File and Progress from the last heartbeat as models.FileProgressUpdate.
+type ZCAD_LoadFileProgress struct {
+	File       string
+	Progress   int32              // Progress of the file in percent
+	Checkpoint libzcad.Checkpoint // Steps of the file that are loaded
 }
 
+// ZCAD_LoadFile loads the file and heartbeats its progress every second. A retried attempt
+// continues from the checkpoint of the last heartbeat of the previous attempt.
 func ZCAD_LoadFile(ctx context.Context, file string) (*ZCAD_LoadFileResult, error) {
 	log.Infof("ZCAD_LoadFile %s", file)
-	libzcad.Hello(file)
-	return &ZCAD_LoadFileResult{file, "Success"}, nil
+	start := time.Now()
+
+	// retrying a file of the wrong format cannot succeed
+	if ext := strings.ToLower(filepath.Ext(file)); !supportedFormats[ext] {
+		return nil, temporal.NewNonRetryableApplicationError("unsupported file format "+ext, models.ErrorUnsupportedFormat, nil)
+	}
+
+	current := ZCAD_LoadFileProgress{File: file}
+	if activity.HasHeartbeatDetails(ctx) {
+		var last ZCAD_LoadFileProgress
+		if err := activity.GetHeartbeatDetails(ctx, &last); err == nil && last.File == file {
+			log.Infof("ZCAD_LoadFile %s resumes after step %d, attempt %d", file, last.Checkpoint.Step, activity.GetInfo(ctx).Attempt)
+			current = last
+		}
+	}
+
+	var mu sync.Mutex
+	done := make(chan error, 1)
+	go func() {
+		done <- libzcad.Load(ctx, file, current.Checkpoint, func(checkpoint libzcad.Checkpoint) {
+			mu.Lock()
+			defer mu.Unlock()
+			current.Checkpoint, current.Progress = checkpoint, checkpoint.Percent()
+		})
+	}()
+
+	// heartbeat while the file is processed, the context is cancelled
+	// by a heartbeat once the job is cancelled.
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case err := <-done:
+			if err != nil && ctx.Err() != nil {
+				log.Infof("ZCAD_LoadFile %s stopped: %v", file, err)
+				return nil, err
+			}
+			if err != nil {
+				log.Infof("ZCAD_LoadFile %s failed: %v", file, err)
+				return nil, temporal.NewApplicationErrorWithCause(err.Error(), models.ErrorConversionFailed, err)
+			}
+			return &ZCAD_LoadFileResult{
+				File:     file,
+				Status:   "Success",
+				Outputs:  []string{libzcad.Output(file)},
+				Duration: time.Since(start),
+			}, nil
+		case <-ticker.C:
+			mu.Lock()
+			details := current
+			mu.Unlock()
+			activity.RecordHeartbeat(ctx, details)
+		}
+	}
 }
@@ -1,6 +1,9 @@
 package zcadworker
 
 import (
+	"transform2/dispatch"
+	"transform2/models"
+
 	"gitlab.zixel.cn/go/framework/config"
 	"gitlab.zixel.cn/go/framework/logger"
 	"go.temporal.io/sdk/client"
@@ -24,13 +27,33 @@ func Start() error {
 	}
 	defer c.Close()
 
-	w := worker.New(c, "zcad-queue", worker.Options{})
+	// The job type of ZCAD is registered with this task queue, VIP jobs go to the priority queue.
+	// The priority queue is polled by more pollers, so its tasks are picked up first.
+	taskQueue := config.GetString("zcad.task_queue", "zcad-queue")
+	vipTaskQueue := dispatch.TaskQueue(taskQueue, models.PriorityVIP, config.GetString("priority.vip_queue_suffix", "-vip"))
+
+	w := worker.New(c, taskQueue, worker.Options{
+		MaxConcurrentWorkflowTaskPollers: int(config.GetInt("zcad.pollers", 2)),
+		MaxConcurrentActivityTaskPollers: int(config.GetInt("zcad.pollers", 2)),
+	})
+	vip := worker.New(c, vipTaskQueue, worker.Options{
+		MaxConcurrentWorkflowTaskPollers: int(config.GetInt("zcad.vip_pollers", 8)),
+		MaxConcurrentActivityTaskPollers: int(config.GetInt("zcad.vip_pollers", 8)),
+	})
 
 	// This worker hosts both Workflow and Activity functions.
-	w.RegisterWorkflow(ScheduleWorkflow)
-	w.RegisterActivity(ZCAD_LoadFile)
+	for _, w := range []worker.Worker{vip, w} {
+		w.RegisterWorkflow(ScheduleWorkflow)
+		w.RegisterActivity(ZCAD_LoadFile)
+	}
+
+	// Start listening to the priority Task Queue, the other one runs until interrupted.
+	if err = vip.Start(); err != nil {
+		log.Fatalln("unable to start VIP Worker", err)
+		return err
+	}
+	defer vip.Stop()
 
-	// Start listening to the Task Queue.
 	ch := worker.InterruptCh()
 	err = w.Run(ch)
 	if err != nil {
@@ -3,16 +3,171 @@ package zcadworker
 import (
 	"encoding/base64"
 	"time"
+	"transform2/models"
 
 	"github.com/bytedance/sonic"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 )
 
+// parallelFiles is the number of files of a job that are loaded at the same time.
+const parallelFiles = 8
+
+// loadFileTimeout bounds an attempt of ZCAD_LoadFile, ZCAD loads about 1 GB in 10 minutes.
+// A stuck attempt is detected by its missing heartbeats long before. The files of a window
+// are scheduled at once and wait for a free worker for up to the same time.
+const loadFileTimeout = time.Hour * 2
+
+// fileResultsChange versions the file results of ScheduleWorkflow with its options, the window of
+// parallel files and the pause signals. Workflows started before replay loadAllFiles.
+const fileResultsChange = "file-results"
+
 type ZCAD_LoadFileParams struct {
 	Files []string `json:"files"`
 }
 
-func ScheduleWorkflow(ctx workflow.Context, token string, parameters string) error {
+// ScheduleWorkflow loads every file of the job and returns the result of each file,
+// the fixed parameters of the job type are not used by ZCAD. The JobPauseSignal stops
+// dispatching further files until the JobResumeSignal arrives. The activities heartbeat the
+// progress of their files, the server reads it from the pending activities of the workflow.
+func ScheduleWorkflow(ctx workflow.Context, token string, parameters string, fixedParameters []string, options models.WorkflowOptions) ([]models.FileProgress, error) {
+	if workflow.GetVersion(ctx, fileResultsChange, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		return nil, loadAllFiles(ctx, parameters)
+	}
+
+	// Apply the options, the job type may declare other timeouts scaled by the input size.
+	activityOptions := workflow.ActivityOptions{
+		ScheduleToStartTimeout: loadFileTimeout,
+		StartToCloseTimeout:    loadFileTimeout,
+		HeartbeatTimeout:       time.Second * 10,
+		WaitForCancellation:    true,
+		RetryPolicy:            options.RetryPolicy.TemporalPolicy(),
+	}
+	options.Timeouts.ApplyTo(&activityOptions, options.InputSize)
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+
+	// report the progress of the job to GetJobInfo
+	progress := models.JobProgress{Status: models.JobStatusRunning}
+	if err := workflow.SetQueryHandler(ctx, models.JobProgressQuery, func() (models.JobProgress, error) {
+		return progress, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	dec, err := base64.StdEncoding.DecodeString(parameters)
+	if err != nil {
+		return nil, err
+	}
+
+	parameters = string(dec)
+
+	LoadFileParams := ZCAD_LoadFileParams{}
+	if err = sonic.Unmarshal([]byte(parameters), &LoadFileParams); err != nil {
+		return nil, err
+	}
+
+	// a retried job only loads the files that failed before
+	if len(options.Files) > 0 {
+		LoadFileParams.Files = options.Files
+	}
+
+	// pause and resume signals are handled while the files are loaded
+	paused := false
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		pauseCh := workflow.GetSignalChannel(ctx, models.JobPauseSignal)
+		resumeCh := workflow.GetSignalChannel(ctx, models.JobResumeSignal)
+		for {
+			selector := workflow.NewSelector(ctx)
+			selector.AddReceive(pauseCh, func(c workflow.ReceiveChannel, more bool) {
+				c.Receive(ctx, nil)
+				log.Infof("Job paused.")
+				paused = true
+				progress.Status = models.JobStatusPaused
+			})
+			selector.AddReceive(resumeCh, func(c workflow.ReceiveChannel, more bool) {
+				c.Receive(ctx, nil)
+				log.Infof("Job resumed.")
+				paused = false
+				progress.Status = models.JobStatusRunning
+			})
+			selector.Select(ctx)
+		}
+	})
+
+	// files are dispatched in a window of parallelFiles, a paused job dispatches no further files
+	// while the files already dispatched finish and keep their results.
+	running := 0
+	progress.Files = make([]models.FileProgress, len(LoadFileParams.Files))
+	for i, file := range LoadFileParams.Files {
+		progress.Files[i] = models.FileProgress{File: file, Status: models.FileStatusPending}
+	}
+	for i, file := range LoadFileParams.Files {
+		if err := workflow.Await(ctx, func() bool { return !paused && running < parallelFiles }); err != nil {
+			break
+		}
+
+		i := i
+		running++
+		scheduled := workflow.Now(ctx)
+		future := workflow.ExecuteActivity(ctx, ZCAD_LoadFile, file)
+		workflow.Go(ctx, func(ctx workflow.Context) {
+			var res ZCAD_LoadFileResult
+			err := future.Get(ctx, &res)
+			// failed files count the time until the failure was reported, including retries
+			progress.Files[i].DurationMs = workflow.Now(ctx).Sub(scheduled).Milliseconds()
+			if temporal.IsCanceledError(err) {
+				log.Infof("ZCAD_LoadFile %s cancelled.", progress.Files[i].File)
+				progress.Files[i].Status = models.FileStatusCancelled
+				progress.Files[i].ErrorCategory = models.ErrorCancelled
+			} else if err != nil || res.Status != "Success" {
+				log.Error("ZCAD_LoadFile failed.", err)
+				progress.Files[i].Status = models.FileStatusFailed
+				progress.Files[i].ErrorCategory = models.ErrorConversionFailed
+				if err != nil {
+					progress.Files[i].Message = err.Error()
+					progress.Files[i].ErrorCategory = models.ActivityErrorCategory(err)
+				}
+				if progress.ErrorCategory == "" {
+					progress.ErrorCategory = progress.Files[i].ErrorCategory
+				}
+			} else {
+				log.Infof("ZCAD_LoadFile %s success.", res.File)
+				progress.Files[i].Status = models.FileStatusSucceeded
+				progress.Files[i].Progress = 100
+				progress.Files[i].Outputs = res.Outputs
+				progress.Files[i].DurationMs = res.Duration.Milliseconds()
+			}
+			running--
+			progress.Progress = models.FilesProgress(progress.Files)
+		})
+	}
+
+	// wait until the dispatched files return, they are cancelled with the job
+	waitCtx, _ := workflow.NewDisconnectedContext(ctx)
+	if err := workflow.Await(waitCtx, func() bool { return running == 0 }); err != nil {
+		return nil, err
+	}
+
+	// the job was cancelled, all activities have stopped at this point
+	if ctx.Err() != nil {
+		for i := range progress.Files {
+			if progress.Files[i].Status == models.FileStatusPending {
+				progress.Files[i].Status = models.FileStatusCancelled
+				progress.Files[i].ErrorCategory = models.ErrorCancelled
+			}
+		}
+		progress.Status = models.JobStatusCancelled
+		return nil, ctx.Err()
+	}
+
+	// failed files do not fail the workflow, the job status is derived from the file results
+	progress.Status = models.JobResultStatus(progress.Files)
+	return progress.Files, nil
+}
+
+// loadAllFiles is ScheduleWorkflow before fileResultsChange, it loads all files of the job at once
+// and only logs their results.
+func loadAllFiles(ctx workflow.Context, parameters string) error {
 	// Apply the options.
 	ctx = workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
 		ScheduleToStartTimeout: time.Second * 5,